@@ -0,0 +1,201 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/types"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"slices"
+	"strings"
+)
+
+// fileCache contains the fingerprints of the generated files of a module.
+// The rendering of a file is skipped when its fingerprint is unchanged, and the file has not been modified.
+type fileCache struct {
+	Files    map[string]cacheEntry `json:"files"`    // by output file.
+	Exported bool                  `json:"exported"` // the files have been generated with the exported mocks (`-e`).
+
+	path    string
+	version string // version of the generator.
+}
+
+// cacheEntry is the fingerprint of a generated file.
+type cacheEntry struct {
+	Fingerprint string `json:"fingerprint"` // hash of the inputs of the file.
+	Hash        string `json:"hash"`        // hash of the content of the file.
+}
+
+// loadCache reads the cache of a module, from the user cache directory.
+// A missing or invalid cache is an empty cache.
+func loadCache(root string) (*fileCache, error) {
+	version, err := generatorVersion()
+	if err != nil {
+		return nil, fmt.Errorf("generator version: %w", err)
+	}
+
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	cache := &fileCache{
+		Files:   make(map[string]cacheEntry),
+		path:    filepath.Join(cacheDir, "mocktail", hashString(root)+".json"),
+		version: version,
+	}
+
+	data, err := os.ReadFile(cache.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cache, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if json.Unmarshal(data, cache) != nil || cache.Files == nil {
+		cache.Files = make(map[string]cacheEntry)
+	}
+
+	return cache, nil
+}
+
+// save writes the cache, the entries of the files not generated anymore are removed.
+func (c *fileCache) save(outs []string) error {
+	for out := range c.Files {
+		if !slices.Contains(outs, out) {
+			delete(c.Files, out)
+		}
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(c.path), 0o750)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.path, data, 0o600)
+}
+
+// generatedFiles returns the files recorded by the previous run.
+// Without exported mocks, the files of a run with exported mocks are not returned: the exported mocks are not orphaned.
+func (c *fileCache) generatedFiles(exported bool) []string {
+	if c == nil || (c.Exported && !exported) {
+		return nil
+	}
+
+	return slices.Sorted(maps.Keys(c.Files))
+}
+
+// isUpToDate reports whether a file has been generated with the same fingerprint, and not modified since.
+func (c *fileCache) isUpToDate(out, fingerprint string) bool {
+	entry, ok := c.Files[out]
+	if !ok || entry.Fingerprint != fingerprint {
+		return false
+	}
+
+	content, err := os.ReadFile(out)
+	if err != nil {
+		return false
+	}
+
+	return hashBytes(content) == entry.Hash
+}
+
+// fingerprint returns the hash of the inputs of a generated file:
+// the generator version, the template, the mocks (names and options), and the type signatures of the interfaces.
+// The imports are not part of the fingerprint: they are defined by the signatures, and modified by the rendering.
+func (c *fileCache) fingerprint(pkgDesc PackageDesc, templateFile string) (string, error) {
+	var b strings.Builder
+
+	_, _ = fmt.Fprintf(&b, "version %s\n", c.version)
+
+	if pkgDesc.TemplateFile != "" {
+		templateFile = pkgDesc.TemplateFile
+	}
+
+	// The embedded template is part of the generator version.
+	if templateFile != "" {
+		content, err := os.ReadFile(templateFile)
+		if err != nil {
+			return "", err
+		}
+
+		_, _ = fmt.Fprintf(&b, "template %s\n", hashBytes(content))
+	}
+
+	_, _ = fmt.Fprintf(&b, "package %s %s\n", pkgDesc.Pkg.Path(), pkgDesc.Pkg.Name())
+	_, _ = fmt.Fprintf(&b, "build %s\n", pkgDesc.BuildConstraint)
+
+	for _, interfaceDesc := range pkgDesc.Interfaces {
+		_, _ = fmt.Fprintf(&b, "interface %s %s %s %t\n",
+			interfaceDesc.Name, interfaceDesc.MockName, interfaceDesc.ConstructorName, interfaceDesc.Exported)
+
+		if interfaceDesc.FuncType != nil {
+			_, _ = fmt.Fprintf(&b, "func %s\n", types.TypeString(interfaceDesc.FuncType, nil))
+		}
+
+		if interfaceDesc.TypeParams != nil {
+			for tp := range interfaceDesc.TypeParams.TypeParams() {
+				_, _ = fmt.Fprintf(&b, "type param %s %s\n", tp.Obj().Name(), types.TypeString(tp.Constraint(), nil))
+			}
+		}
+
+		for _, method := range interfaceDesc.Methods {
+			_, _ = fmt.Fprintf(&b, "method %s %s\n", method.Name(), types.TypeString(method.Signature(), nil))
+		}
+	}
+
+	return hashString(b.String()), nil
+}
+
+// generatorVersion returns the version of the generator.
+// The development builds are identified by the hash of the executable.
+func generatorVersion() (string, error) {
+	info, ok := debug.ReadBuildInfo()
+	if ok && info.Main.Version != "" && info.Main.Version != "(devel)" && !strings.HasSuffix(info.Main.Version, "+dirty") {
+		return info.Main.Version, nil
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+
+	file, err := os.Open(exe)
+	if err != nil {
+		return "", err
+	}
+
+	defer func() { _ = file.Close() }()
+
+	hash := sha256.New()
+
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func hashString(s string) string {
+	return hashBytes([]byte(s))
+}
+
+func hashBytes(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
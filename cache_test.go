@@ -0,0 +1,97 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_fileCache_fingerprint(t *testing.T) {
+	cache := &fileCache{version: "v1.0.0"}
+
+	pkgDesc := createSimpleTestPackage("a")
+
+	fingerprint, err := cache.fingerprint(pkgDesc, "")
+	require.NoError(t, err)
+
+	again, err := cache.fingerprint(pkgDesc, "")
+	require.NoError(t, err)
+	assert.Equal(t, fingerprint, again)
+
+	// The name of the mock.
+	renamed := createSimpleTestPackage("a")
+	renamed.Interfaces[0].MockName = "fooStub"
+
+	other, err := cache.fingerprint(renamed, "")
+	require.NoError(t, err)
+	assert.NotEqual(t, fingerprint, other)
+
+	// The version of the generator.
+	other, err = (&fileCache{version: "v1.1.0"}).fingerprint(pkgDesc, "")
+	require.NoError(t, err)
+	assert.NotEqual(t, fingerprint, other)
+
+	// The custom template.
+	templateFile := filepath.Join(t.TempDir(), "mock.tmpl")
+	writeFile(t, templateFile, "{{ .Name }}")
+
+	other, err = cache.fingerprint(pkgDesc, templateFile)
+	require.NoError(t, err)
+	assert.NotEqual(t, fingerprint, other)
+
+	_, err = cache.fingerprint(pkgDesc, filepath.Join(t.TempDir(), "missing.tmpl"))
+	require.Error(t, err)
+}
+
+func Test_generate_cache(t *testing.T) {
+	tmpl, err := getTemplate("")
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+
+	cache := &fileCache{
+		Files:   map[string]cacheEntry{},
+		path:    filepath.Join(dir, "cache", "module.json"),
+		version: "v1.0.0",
+	}
+
+	out := filepath.Join(dir, "a", outputMockFile)
+
+	model := map[string]PackageDesc{out: createSimpleTestPackage("a")}
+
+	err = generate(model, tmpl, generateOptions{Cache: cache})
+	require.NoError(t, err)
+	require.Contains(t, cache.Files, out)
+	assert.FileExists(t, cache.path)
+
+	// The rendering is skipped: the template is not executed.
+	empty := template.New("empty")
+
+	err = generate(model, empty, generateOptions{Cache: cache})
+	require.NoError(t, err)
+
+	// A modified file is rendered again.
+	writeFile(t, out, generatedHeader+"\n\npackage a\n")
+
+	err = generate(model, empty, generateOptions{Cache: cache})
+	require.Error(t, err)
+
+	err = generate(model, tmpl, generateOptions{Cache: cache})
+	require.NoError(t, err)
+
+	// An unchanged file is not written.
+	past := time.Now().Add(-time.Hour).Truncate(time.Second)
+	require.NoError(t, os.Chtimes(out, past, past))
+
+	err = generate(model, tmpl, generateOptions{})
+	require.NoError(t, err)
+
+	info, err := os.Stat(out)
+	require.NoError(t, err)
+	assert.Equal(t, past, info.ModTime())
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// generatedHeader is the first line of the files generated with the default template.
+const generatedHeader = "// Code generated by mocktail; DO NOT EDIT."
+
+// findGeneratedFiles walks the module and returns the files generated by mocktail.
+// Without exported mocks, only the test files and the recorded files (generated by a previous run without exported mocks) are returned:
+// the directives of the other files are not collected, their mocks cannot be considered as orphaned.
+// The nested modules, and the files ignored by the matcher, are skipped.
+func findGeneratedFiles(root string, exported bool, recorded []string, ignore *ignoreMatcher) ([]string, error) {
+	var files []string
+
+	err := walkModule(root, ignore, func(fp string, d fs.DirEntry) error {
+		if !strings.HasSuffix(d.Name(), ".go") {
+			return nil
+		}
+
+		if !exported && !strings.HasSuffix(d.Name(), "_test.go") && !slices.Contains(recorded, fp) {
+			return nil
+		}
+
+		generated, err := isGeneratedFile(fp)
+		if err != nil {
+			return err
+		}
+
+		if generated {
+			files = append(files, fp)
+		}
+
+		return nil
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("walk dir: %w", err)
+	}
+
+	return files, nil
+}
+
+// isGeneratedFile reports whether the file starts with the header of the generated files.
+func isGeneratedFile(fp string) (bool, error) {
+	file, err := os.Open(fp)
+	if err != nil {
+		return false, err
+	}
+
+	defer func() { _ = file.Close() }()
+
+	line, err := bufio.NewReader(file).ReadString('\n')
+	if err != nil && len(line) == 0 {
+		// Empty file.
+		return false, nil
+	}
+
+	return hasGeneratedHeader([]byte(line)), nil
+}
+
+// hasGeneratedHeader reports whether the content of a file starts with the header of the generated files.
+func hasGeneratedHeader(content []byte) bool {
+	line, _, _ := bytes.Cut(content, []byte("\n"))
+
+	return string(bytes.TrimRight(line, "\r")) == generatedHeader
+}
+
+// removeOrphans removes the generated files without mocks in the model,
+// or reports them in check mode.
+// The files of the directories with unresolved directives are kept: their mocks may still be used.
+// The recorded files are the files generated by the previous run (ex: the outputs of the `package` option).
+func removeOrphans(root string, model map[string]PackageDesc, unresolved, recorded []string, exported bool, excludes []string, check bool) error {
+	files, err := findGeneratedFiles(root, exported, recorded, newIgnoreMatcher(root, excludes))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, fp := range files {
+		if _, ok := model[fp]; ok || slices.Contains(unresolved, filepath.Dir(fp)) {
+			continue
+		}
+
+		if check {
+			errs = append(errs, fmt.Errorf("%s: orphaned generated file (no directives)", fp))
+			continue
+		}
+
+		err = os.Remove(fp)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		log.Println("removed", fp)
+	}
+
+	return errors.Join(errs...)
+}
+
+// clean removes all the generated mocks of a module.
+func clean(mod modInfo, flags cliFlags) error {
+	cfg, err := loadConfig(mod.Dir)
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	excludes := append(slices.Clone(flags.Excludes), cfg.Exclude...)
+
+	files, err := findGeneratedFiles(mod.Dir, true, nil, newIgnoreMatcher(mod.Dir, excludes))
+	if err != nil {
+		return fmt.Errorf("clean: %w", err)
+	}
+
+	for _, fp := range files {
+		err = os.Remove(fp)
+		if err != nil {
+			return fmt.Errorf("clean: %w", err)
+		}
+
+		log.Println("removed", fp)
+	}
+
+	return nil
+}
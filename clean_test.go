@@ -0,0 +1,104 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_removeOrphans(t *testing.T) {
+	root := t.TempDir()
+
+	generated := generatedHeader + "\n\npackage a\n"
+
+	writeFile(t, filepath.Join(root, "go.mod"), "module orphans\n\ngo 1.24\n")
+	writeFile(t, filepath.Join(root, "a", "mock_gen_test.go"), generated)
+	writeFile(t, filepath.Join(root, "a", "mock_integration_gen_test.go"), generated)
+	writeFile(t, filepath.Join(root, "a", "mock_gen.go"), generated)
+	writeFile(t, filepath.Join(root, "a", "a_test.go"), "package a\n")
+	writeFile(t, filepath.Join(root, "nested", "go.mod"), "module nested\n\ngo 1.24\n")
+	writeFile(t, filepath.Join(root, "nested", "mock_gen_test.go"), generated)
+
+	model := map[string]PackageDesc{
+		filepath.Join(root, "a", "mock_gen_test.go"): {},
+	}
+
+	err := removeOrphans(root, model, nil, nil, false, nil, true)
+	require.EqualError(t, err, filepath.Join(root, "a", "mock_integration_gen_test.go")+": orphaned generated file (no directives)")
+	assert.FileExists(t, filepath.Join(root, "a", "mock_integration_gen_test.go"))
+
+	err = removeOrphans(root, model, nil, nil, false, nil, false)
+	require.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(root, "a", "mock_gen_test.go"))
+	assert.NoFileExists(t, filepath.Join(root, "a", "mock_integration_gen_test.go"))
+	assert.FileExists(t, filepath.Join(root, "a", "a_test.go"))
+	assert.FileExists(t, filepath.Join(root, "nested", "mock_gen_test.go"))
+
+	// The directives of the non-test files are only collected for the exported mocks.
+	assert.FileExists(t, filepath.Join(root, "a", "mock_gen.go"))
+
+	err = removeOrphans(root, model, nil, nil, true, nil, false)
+	require.NoError(t, err)
+
+	assert.NoFileExists(t, filepath.Join(root, "a", "mock_gen.go"))
+
+	err = clean(modInfo{Dir: root, Path: "orphans"}, cliFlags{})
+	require.NoError(t, err)
+
+	assert.NoFileExists(t, filepath.Join(root, "a", "mock_gen_test.go"))
+	assert.FileExists(t, filepath.Join(root, "a", "a_test.go"))
+}
+
+func Test_removeOrphans_unresolved(t *testing.T) {
+	root := t.TempDir()
+
+	writeFile(t, filepath.Join(root, "go.mod"), "module orphans\n\ngo 1.24\n")
+	writeFile(t, filepath.Join(root, "store", "store.go"), "package store\n\ntype Watcher interface {\n\tWatch() error\n}\n")
+	writeFile(t, filepath.Join(root, "store", "mock_test.go"), "package store\n\n// mocktail:Wacher\n")
+	writeFile(t, filepath.Join(root, "store", "mock_gen_test.go"), generatedHeader+"\n\npackage store\n")
+
+	model, unresolved, err := walk(root, "orphans", walkOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, model)
+	assert.Equal(t, []string{filepath.Join(root, "store")}, unresolved)
+
+	// The mocks of the unresolved directives are not orphaned.
+	err = removeOrphans(root, model, unresolved, nil, false, nil, false)
+	require.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(root, "store", "mock_gen_test.go"))
+}
+
+func Test_removeOrphans_recorded(t *testing.T) {
+	root := t.TempDir()
+
+	generated := generatedHeader + "\n\npackage storemock\n"
+
+	writeFile(t, filepath.Join(root, "go.mod"), "module orphans\n\ngo 1.24\n")
+	writeFile(t, filepath.Join(root, "storemock", "mock_gen.go"), generated)
+	writeFile(t, filepath.Join(root, "ordermock", "mock_gen.go"), generated)
+
+	// The output of a removed directive with the option `package`, generated by the previous run.
+	cache := &fileCache{Files: map[string]cacheEntry{
+		filepath.Join(root, "storemock", "mock_gen.go"): {},
+	}}
+
+	err := removeOrphans(root, nil, nil, cache.generatedFiles(false), false, nil, false)
+	require.NoError(t, err)
+
+	assert.NoFileExists(t, filepath.Join(root, "storemock", "mock_gen.go"))
+	assert.FileExists(t, filepath.Join(root, "ordermock", "mock_gen.go"))
+
+	// The files generated with the exported mocks are kept without `-e`.
+	cache = &fileCache{Exported: true, Files: map[string]cacheEntry{
+		filepath.Join(root, "ordermock", "mock_gen.go"): {},
+	}}
+
+	err = removeOrphans(root, nil, nil, cache.generatedFiles(false), false, nil, false)
+	require.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(root, "ordermock", "mock_gen.go"))
+}
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"go/token"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+const configFileName = ".mocktail.yaml"
+
+// config represents the configuration file (`.mocktail.yaml`) at the root of the module.
+//
+//	exported: false
+//	strict: true
+//	template: mocktail.tmpl
+//	package: ../{{ .PackageName }}mock
+//	build-tag: mocks
+//	tags: [enterprise]
+//	build-flags: [-mod=vendor]
+//	env: [GOOS=windows]
+//	exclude:
+//	  - "**/generated"
+//	naming:
+//	  mock: "{{ .InterfaceName | ToGoCamel }}Stub"
+//	  constructor: "new{{ .InterfaceName | ToGoPascal }}Stub"
+//	packages:
+//	  - path: store
+//	    interfaces:
+//	      - UserRepo
+//	      - interface: io.Reader
+//	        name: readerStub
+//	        file: reader_mock_test.go
+type config struct {
+	Exported   *bool           `yaml:"exported"`
+	Strict     *bool           `yaml:"strict"`
+	Template   string          `yaml:"template"`
+	Package    string          `yaml:"package"`
+	BuildTag   string          `yaml:"build-tag"`
+	Tags       []string        `yaml:"tags"`
+	BuildFlags []string        `yaml:"build-flags"`
+	Env        []string        `yaml:"env"`
+	Exclude    []string        `yaml:"exclude"`
+	Naming     namingConfig    `yaml:"naming"`
+	Packages   []packageConfig `yaml:"packages"`
+
+	path string
+}
+
+// namingConfig contains the patterns (templates) of the mock names.
+type namingConfig struct {
+	Mock        string `yaml:"mock"`
+	Constructor string `yaml:"constructor"`
+
+	mockTmpl        *template.Template
+	constructorTmpl *template.Template
+}
+
+// packageConfig contains the mocks of a package.
+type packageConfig struct {
+	Path       string            `yaml:"path"` // directory relative to the module root.
+	Exported   *bool             `yaml:"exported"`
+	File       string            `yaml:"file"`
+	Template   string            `yaml:"template"`
+	Package    string            `yaml:"package"`
+	Interfaces []interfaceConfig `yaml:"interfaces"`
+}
+
+// interfaceConfig is the equivalent of a directive.
+// It can be a simple string (the target of the directive) or an object.
+type interfaceConfig struct {
+	Interface   string `yaml:"interface"`
+	Name        string `yaml:"name"`
+	Constructor string `yaml:"constructor"`
+	Exported    *bool  `yaml:"exported"`
+	File        string `yaml:"file"`
+	Template    string `yaml:"template"`
+	Package     string `yaml:"package"`
+	Exclude     bool   `yaml:"exclude"`
+
+	line int
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (c *interfaceConfig) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		c.Interface = value.Value
+	} else {
+		type plain interfaceConfig
+
+		err := value.Decode((*plain)(c))
+		if err != nil {
+			return err
+		}
+	}
+
+	c.line = value.Line
+
+	return nil
+}
+
+// namingData contains the data of the naming patterns.
+type namingData struct {
+	InterfaceName string
+	Exported      bool
+}
+
+// loadConfig reads the configuration file at the root of the module.
+// The configuration file is optional.
+func loadConfig(root string) (config, error) {
+	fp := filepath.Join(root, configFileName)
+
+	data, err := os.ReadFile(fp)
+	if errors.Is(err, fs.ErrNotExist) {
+		return config{}, nil
+	}
+
+	if err != nil {
+		return config{}, err
+	}
+
+	cfg := config{path: fp}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	err = decoder.Decode(&cfg)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return config{}, fmt.Errorf("%s: %w", fp, err)
+	}
+
+	for _, env := range cfg.Env {
+		if !strings.Contains(env, "=") {
+			return config{}, fmt.Errorf("%s: env: invalid variable %q: must be KEY=VALUE", fp, env)
+		}
+	}
+
+	cfg.Naming.mockTmpl, err = parseNamingPattern(cfg.Naming.Mock)
+	if err != nil {
+		return config{}, fmt.Errorf("%s: naming.mock: %w", fp, err)
+	}
+
+	cfg.Naming.constructorTmpl, err = parseNamingPattern(cfg.Naming.Constructor)
+	if err != nil {
+		return config{}, fmt.Errorf("%s: naming.constructor: %w", fp, err)
+	}
+
+	return cfg, nil
+}
+
+// directives converts the configuration to directives grouped by package directory.
+// The valid directives are returned with the errors of the invalid directives.
+func (c config) directives(root string) (map[string][]directive, error) {
+	directives := make(map[string][]directive)
+
+	var errs []error
+
+	for _, pkg := range c.Packages {
+		dir := filepath.Join(root, filepath.FromSlash(pkg.Path))
+
+		for _, itf := range pkg.Interfaces {
+			d := directive{
+				Pos:    token.Position{Filename: c.path, Line: itf.line},
+				Target: itf.Interface,
+				Options: directiveOptions{
+					Name:        itf.Name,
+					Constructor: itf.Constructor,
+					Exported:    cmp.Or(itf.Exported, pkg.Exported),
+					File:        cmp.Or(itf.File, pkg.File),
+					Template:    cmp.Or(itf.Template, pkg.Template),
+					Package:     cmp.Or(itf.Package, pkg.Package),
+				},
+				Exclude: itf.Exclude,
+			}
+
+			err := d.validate()
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			directives[dir] = append(directives[dir], d)
+		}
+	}
+
+	return directives, errors.Join(errs...)
+}
+
+// mockName returns the name of the mock type, based on the naming pattern.
+// Without pattern, the type of a mock generated in another package than the package of its directive is exported.
+func (n namingConfig) mockName(interfaceName string, exported, otherPackage bool) (string, error) {
+	if n.mockTmpl == nil {
+		return getMockName(interfaceName, otherPackage), nil
+	}
+
+	return executeNamingPattern(n.mockTmpl, namingData{InterfaceName: interfaceName, Exported: exported})
+}
+
+// constructorName returns the name of the mock constructor, based on the naming pattern.
+func (n namingConfig) constructorName(interfaceName string, exported bool) (string, error) {
+	if n.constructorTmpl == nil {
+		return getConstructorName(interfaceName, exported), nil
+	}
+
+	return executeNamingPattern(n.constructorTmpl, namingData{InterfaceName: interfaceName, Exported: exported})
+}
+
+// mergeDirectives merges the directives from the configuration with the directives from the comments.
+// An interface declared in both with different options is a conflict:
+// the targets are compared once resolved (ex: `store.Foo` in the configuration and `Foo` in a comment of the package store).
+func mergeDirectives(root, moduleName string, comments, configured map[string][]directive) (map[string][]directive, error) {
+	var conflicts []error
+
+	for _, dir := range slices.Sorted(maps.Keys(configured)) {
+		rel, err := filepath.Rel(root, dir)
+		if err != nil {
+			return nil, err
+		}
+
+		pkgPath := path.Join(moduleName, filepath.ToSlash(rel))
+
+		for _, cfgDirective := range configured[dir] {
+			duplicated := false
+
+			for _, d := range comments[dir] {
+				if d.Exclude != cfgDirective.Exclude || !sameTarget(moduleName, pkgPath, d.Target, cfgDirective.Target) {
+					continue
+				}
+
+				if !reflect.DeepEqual(d.Options, cfgDirective.Options) {
+					conflicts = append(conflicts, fmt.Errorf("%s: %s conflicts with the comment %s: the options are different",
+						cfgDirective.Pos, cfgDirective.Target, d.Pos))
+				}
+
+				duplicated = true
+			}
+
+			if !duplicated {
+				comments[dir] = append(comments[dir], cfgDirective)
+			}
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return nil, errors.Join(conflicts...)
+	}
+
+	return comments, nil
+}
+
+// sameTarget reports whether two targets of directives of the same package designate the same type.
+// A qualified target can designate a type of the package itself: the candidate import paths are compared.
+func sameTarget(moduleName, pkgPath, target, other string) bool {
+	_, typeArgs := splitTypeArgs(target)
+	_, otherTypeArgs := splitTypeArgs(other)
+
+	if typeArgs != otherTypeArgs {
+		return false
+	}
+
+	importPaths, name := getImportPaths(moduleName, pkgPath, target)
+	otherPaths, otherName := getImportPaths(moduleName, pkgPath, other)
+
+	if name != otherName {
+		return false
+	}
+
+	return slices.ContainsFunc(importPaths, func(importPath string) bool {
+		return slices.Contains(otherPaths, importPath)
+	})
+}
+
+func parseNamingPattern(pattern string) (*template.Template, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+
+	return template.New("naming").Funcs(templateFuncs).Parse(pattern)
+}
+
+func executeNamingPattern(tmpl *template.Template, data namingData) (string, error) {
+	var buffer bytes.Buffer
+
+	err := tmpl.Execute(&buffer, data)
+	if err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
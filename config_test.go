@@ -0,0 +1,168 @@
+package main
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_loadConfig(t *testing.T) {
+	root := t.TempDir()
+
+	content := `exported: true
+naming:
+  mock: "{{ .InterfaceName | ToGoCamel }}Stub"
+  constructor: "{{ if .Exported }}New{{ else }}new{{ end }}{{ .InterfaceName | ToGoPascal }}Stub"
+packages:
+  - path: store
+    file: store_mock.go
+    interfaces:
+      - UserRepo
+      - interface: io.Reader
+        name: readerStub
+        exported: false
+        file: reader_mock_test.go
+      - interface: Cache
+        exclude: true
+`
+
+	err := os.WriteFile(filepath.Join(root, configFileName), []byte(content), 0o600)
+	require.NoError(t, err)
+
+	cfg, err := loadConfig(root)
+	require.NoError(t, err)
+
+	assert.Equal(t, ptr(true), cfg.Exported)
+
+	mockName, err := cfg.Naming.mockName("UserRepo", true, false)
+	require.NoError(t, err)
+	assert.Equal(t, "userRepoStub", mockName)
+
+	constructorName, err := cfg.Naming.constructorName("UserRepo", true)
+	require.NoError(t, err)
+	assert.Equal(t, "NewUserRepoStub", constructorName)
+
+	directives, err := cfg.directives(root)
+	require.NoError(t, err)
+
+	fp := filepath.Join(root, configFileName)
+
+	expected := map[string][]directive{
+		filepath.Join(root, "store"): {
+			{
+				Pos:     token.Position{Filename: fp, Line: 9},
+				Target:  "UserRepo",
+				Options: directiveOptions{File: "store_mock.go"},
+			},
+			{
+				Pos:     token.Position{Filename: fp, Line: 10},
+				Target:  "io.Reader",
+				Options: directiveOptions{Name: "readerStub", Exported: ptr(false), File: "reader_mock_test.go"},
+			},
+			{
+				Pos:     token.Position{Filename: fp, Line: 14},
+				Target:  "Cache",
+				Options: directiveOptions{File: "store_mock.go"},
+				Exclude: true,
+			},
+		},
+	}
+
+	assert.Equal(t, expected, directives)
+}
+
+func Test_loadConfig_missing(t *testing.T) {
+	cfg, err := loadConfig(t.TempDir())
+	require.NoError(t, err)
+
+	assert.Equal(t, config{}, cfg)
+
+	mockName, err := cfg.Naming.mockName("UserRepo", false, false)
+	require.NoError(t, err)
+	assert.Equal(t, "userRepoMock", mockName)
+
+	mockName, err = cfg.Naming.mockName("UserRepo", true, true)
+	require.NoError(t, err)
+	assert.Equal(t, "UserRepoMock", mockName)
+}
+
+func Test_loadConfig_unknownField(t *testing.T) {
+	root := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(root, configFileName), []byte("packages:\n  - pth: store\n"), 0o600)
+	require.NoError(t, err)
+
+	_, err = loadConfig(root)
+	require.ErrorContains(t, err, "field pth not found")
+}
+
+func Test_loadConfig_invalidEnv(t *testing.T) {
+	root := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(root, configFileName), []byte("env:\n  - GOOS\n"), 0o600)
+	require.NoError(t, err)
+
+	_, err = loadConfig(root)
+	require.ErrorContains(t, err, `env: invalid variable "GOOS": must be KEY=VALUE`)
+}
+
+func Test_mergeDirectives(t *testing.T) {
+	comments := map[string][]directive{
+		"store": {
+			{Pos: token.Position{Filename: "store/mock_test.go", Line: 3}, Target: "UserRepo"},
+		},
+	}
+
+	configured := map[string][]directive{
+		"store": {
+			{Pos: token.Position{Filename: configFileName, Line: 4}, Target: "UserRepo"},
+			{Pos: token.Position{Filename: configFileName, Line: 5}, Target: "OrderRepo"},
+		},
+	}
+
+	directives, err := mergeDirectives(".", "app", comments, configured)
+	require.NoError(t, err)
+
+	expected := map[string][]directive{
+		"store": {
+			{Pos: token.Position{Filename: "store/mock_test.go", Line: 3}, Target: "UserRepo"},
+			{Pos: token.Position{Filename: configFileName, Line: 5}, Target: "OrderRepo"},
+		},
+	}
+
+	assert.Equal(t, expected, directives)
+}
+
+func Test_mergeDirectives_conflict(t *testing.T) {
+	comments := map[string][]directive{
+		"store": {
+			{Pos: token.Position{Filename: "store/mock_test.go", Line: 3}, Target: "UserRepo"},
+			{Pos: token.Position{Filename: "store/mock_test.go", Line: 4}, Target: "OrderRepo"},
+			{Pos: token.Position{Filename: "store/mock_test.go", Line: 5}, Target: "Repository[User]"},
+		},
+	}
+
+	configured := map[string][]directive{
+		"store": {
+			{Pos: token.Position{Filename: configFileName, Line: 4}, Target: "UserRepo", Options: directiveOptions{Name: "userStub"}},
+			// The target is resolved inside the package of the directive.
+			{Pos: token.Position{Filename: configFileName, Line: 5}, Target: "store.OrderRepo", Options: directiveOptions{Name: "orderStub"}},
+			// Another instance of the generic type is not a conflict.
+			{Pos: token.Position{Filename: configFileName, Line: 6}, Target: "Repository[Order]", Options: directiveOptions{Name: "orderRepositoryStub"}},
+		},
+	}
+
+	_, err := mergeDirectives(".", "app", comments, configured)
+
+	expected := []string{
+		".mocktail.yaml:4: UserRepo conflicts with the comment store/mock_test.go:3: the options are different",
+		".mocktail.yaml:5: store.OrderRepo conflicts with the comment store/mock_test.go:4: the options are different",
+	}
+
+	require.EqualError(t, err, strings.Join(expected, "\n"))
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"fmt"
+	"go/build/constraint"
+	"slices"
+	"strings"
+)
+
+// knownOS and knownArch are the values of GOOS and GOARCH recognized in the file name suffixes (`_linux_amd64.go`).
+var (
+	knownOS = []string{
+		"aix", "android", "darwin", "dragonfly", "freebsd", "hurd", "illumos", "ios", "js", "linux",
+		"nacl", "netbsd", "openbsd", "plan9", "solaris", "wasip1", "windows", "zos",
+	}
+
+	knownArch = []string{
+		"386", "amd64", "amd64p32", "arm", "armbe", "arm64", "arm64be", "loong64", "mips", "mipsle",
+		"mips64", "mips64le", "mips64p32", "mips64p32le", "ppc", "ppc64", "ppc64le", "riscv", "riscv64",
+		"s390", "s390x", "sparc", "sparc64", "wasm",
+	}
+)
+
+// checkBuildConstraint checks the syntax of a build constraint expression (ex: `mocks && !prod`).
+func checkBuildConstraint(expr string) error {
+	if expr == "" {
+		return nil
+	}
+
+	_, err := constraint.Parse("//go:build " + expr)
+	if err != nil {
+		return fmt.Errorf("invalid build constraint %q: %w", expr, err)
+	}
+
+	return nil
+}
+
+// buildConstraintParser collects the build constraint lines of the header of a Go file (before the package clause).
+// The `// +build` lines are only used when there is no `//go:build` line.
+type buildConstraintParser struct {
+	goBuild    constraint.Expr
+	plusBuild  []constraint.Expr
+	headerDone bool
+}
+
+// parseLine parses a line of the file.
+func (p *buildConstraintParser) parseLine(line string) error {
+	if p.headerDone {
+		return nil
+	}
+
+	line = strings.TrimSpace(line)
+
+	if strings.HasPrefix(line, "package ") {
+		p.headerDone = true
+		return nil
+	}
+
+	switch {
+	case constraint.IsGoBuild(line):
+		expr, err := constraint.Parse(line)
+		if err != nil {
+			return fmt.Errorf("invalid build constraint: %w", err)
+		}
+
+		p.goBuild = expr
+
+	case constraint.IsPlusBuild(line):
+		expr, err := constraint.Parse(line)
+		if err != nil {
+			return fmt.Errorf("invalid build constraint: %w", err)
+		}
+
+		p.plusBuild = append(p.plusBuild, expr)
+	}
+
+	return nil
+}
+
+// expr returns the build constraint of the header, nil if the file is always built.
+func (p *buildConstraintParser) expr() constraint.Expr {
+	if p.goBuild != nil {
+		return p.goBuild
+	}
+
+	return andConstraints(p.plusBuild...)
+}
+
+// fileConstraint returns the build constraint of a Go file: the constraint of its header and the one of its name.
+func fileConstraint(header constraint.Expr, name string) constraint.Expr {
+	return andConstraints(header, fileNameConstraint(name))
+}
+
+// fileNameConstraint returns the build constraint implied by the GOOS and GOARCH suffixes of a file name
+// (`_linux.go`, `_amd64_test.go`, `_linux_amd64.go`), nil if there is none.
+func fileNameConstraint(name string) constraint.Expr {
+	name = strings.TrimSuffix(name, ".go")
+	name = strings.TrimSuffix(name, "_test")
+
+	// The first element is never a suffix (`linux.go` is not constrained).
+	index := strings.Index(name, "_")
+	if index < 0 {
+		return nil
+	}
+
+	parts := strings.Split(name[index:], "_")
+	n := len(parts)
+
+	if n >= 3 && slices.Contains(knownOS, parts[n-2]) && slices.Contains(knownArch, parts[n-1]) {
+		return andConstraints(&constraint.TagExpr{Tag: parts[n-2]}, &constraint.TagExpr{Tag: parts[n-1]})
+	}
+
+	if n >= 2 && (slices.Contains(knownOS, parts[n-1]) || slices.Contains(knownArch, parts[n-1])) {
+		return &constraint.TagExpr{Tag: parts[n-1]}
+	}
+
+	return nil
+}
+
+// andConstraints combines build constraints with `&&`, the nil constraints and the duplicates are ignored.
+func andConstraints(exprs ...constraint.Expr) constraint.Expr {
+	var result constraint.Expr
+
+	var seen []string
+
+	for _, expr := range exprs {
+		if expr == nil || slices.Contains(seen, expr.String()) {
+			continue
+		}
+
+		seen = append(seen, expr.String())
+
+		if result == nil {
+			result = expr
+		} else {
+			result = &constraint.AndExpr{X: result, Y: expr}
+		}
+	}
+
+	return result
+}
+
+// orConstraints combines build constraints with `||`, duplicates are ignored.
+// The result is nil (always built) if one of the constraints is nil.
+func orConstraints(exprs ...constraint.Expr) constraint.Expr {
+	var result constraint.Expr
+
+	var seen []string
+
+	for _, expr := range exprs {
+		if expr == nil {
+			return nil
+		}
+
+		if slices.Contains(seen, expr.String()) {
+			continue
+		}
+
+		seen = append(seen, expr.String())
+
+		if result == nil {
+			result = expr
+		} else {
+			result = &constraint.OrExpr{X: result, Y: expr}
+		}
+	}
+
+	return result
+}
+
+// constraintString returns the expression of a build constraint, an empty string if nil.
+func constraintString(expr constraint.Expr) string {
+	if expr == nil {
+		return ""
+	}
+
+	return expr.String()
+}
+
+// constraintFileName returns the name of the file of the mocks gated by a build constraint
+// (ex: `mock_linux_amd64_gen_test.go` for `linux && amd64`).
+func constraintFileName(expr constraint.Expr, exported bool) string {
+	replacer := strings.NewReplacer("&&", "_", "||", "_or_", "!", "not_")
+
+	var b strings.Builder
+
+	for _, r := range replacer.Replace(strings.ToLower(expr.String())) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == '_':
+			if b.Len() > 0 && !strings.HasSuffix(b.String(), "_") {
+				b.WriteRune(r)
+			}
+		}
+	}
+
+	name := strings.TrimSuffix(b.String(), "_")
+
+	if exported {
+		return "mock_" + name + "_gen.go"
+	}
+
+	return "mock_" + name + "_gen_test.go"
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"go/build/constraint"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_checkBuildConstraint(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		expr     string
+		expected string
+	}{
+		{desc: "empty", expr: ""},
+		{desc: "tag", expr: "mocks"},
+		{desc: "expression", expr: "mocks && !prod"},
+		{desc: "invalid", expr: "mocks &&", expected: `invalid build constraint "mocks &&": `},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkBuildConstraint(test.expr)
+			if test.expected == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, test.expected)
+			}
+		})
+	}
+}
+
+func Test_fileNameConstraint(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected string
+	}{
+		{name: "mock_test.go", expected: ""},
+		{name: "linux.go", expected: ""},
+		{name: "linux_test.go", expected: ""},
+		{name: "mock_linux_test.go", expected: "linux"},
+		{name: "mock_amd64.go", expected: "amd64"},
+		{name: "mock_linux_amd64_test.go", expected: "linux && amd64"},
+		{name: "mock_amd64_linux_test.go", expected: "linux"},
+		{name: "mock_linux_gen_test.go", expected: ""},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, constraintString(fileNameConstraint(test.name)))
+		})
+	}
+}
+
+func Test_orConstraints(t *testing.T) {
+	integration := &constraint.TagExpr{Tag: "integration"}
+	linux := &constraint.TagExpr{Tag: "linux"}
+
+	assert.Equal(t, "integration || linux", constraintString(orConstraints(integration, linux, integration)))
+	assert.Nil(t, orConstraints(integration, nil))
+	assert.Equal(t, "integration && linux", constraintString(andConstraints(integration, nil, linux, integration)))
+}
+
+func Test_constraintFileName(t *testing.T) {
+	testCases := []struct {
+		expr     string
+		exported bool
+		expected string
+	}{
+		{expr: "integration", expected: "mock_integration_gen_test.go"},
+		{expr: "linux && amd64", expected: "mock_linux_amd64_gen_test.go"},
+		{expr: "!windows", expected: "mock_not_windows_gen_test.go"},
+		{expr: "(e2e || integration) && go1.22", expected: "mock_e2e_or_integration_go122_gen_test.go"},
+		{expr: "integration", exported: true, expected: "mock_integration_gen.go"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.expected, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := constraint.Parse("//go:build " + test.expr)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, constraintFileName(expr, test.exported))
+		})
+	}
+}
+
+func Test_scanDirectives_constraint(t *testing.T) {
+	dir := t.TempDir()
+
+	testCases := []struct {
+		desc     string
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			desc:     "go:build",
+			name:     "a_test.go",
+			content:  "//go:build integration\n\npackage a\n\n// mocktail:Foo\n",
+			expected: "integration",
+		},
+		{
+			desc:     "plus build",
+			name:     "b_test.go",
+			content:  "// +build integration e2e\n\npackage a\n\n// mocktail:Foo\n",
+			expected: "integration || e2e",
+		},
+		{
+			desc:     "go:build and file name",
+			name:     "c_linux_test.go",
+			content:  "//go:build integration\n// +build e2e\n\npackage a\n\n// mocktail:Foo\n",
+			expected: "integration && linux",
+		},
+		{
+			desc:     "after the package clause",
+			name:     "d_test.go",
+			content:  "package a\n\n//go:build integration\n\n// mocktail:Foo\n",
+			expected: "",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			fp := filepath.Join(dir, test.name)
+			writeFile(t, fp, test.content)
+
+			directives, err := scanDirectives(fp)
+			require.NoError(t, err)
+			require.Len(t, directives, 1)
+
+			assert.Equal(t, test.expected, constraintString(directives[0].Constraint))
+		})
+	}
+}
@@ -0,0 +1,347 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"go/build/constraint"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// directive represents a `// mocktail:` comment.
+//
+//	// mocktail:Foo name=fooStub constructor=newFooStub exported file=foo_mock.go template=foo.tmpl package=../foomock
+//
+// The target can be an interface name or a name pattern (`*`, `/regexp/`), qualified or not by a package path.
+// An exclusion (`// mocktail-:`) removes the matching interfaces from the other directives of the package.
+type directive struct {
+	Pos        token.Position
+	Target     string
+	Options    directiveOptions
+	Exclude    bool
+	Constraint constraint.Expr // build constraint of the file of the directive, nil if the file is always built.
+}
+
+// directiveOptions contains the options of a directive.
+type directiveOptions struct {
+	Name        string // mock type name.
+	Constructor string // mock constructor name.
+	Exported    *bool  // overrides the `-e` flag.
+	File        string // output file name.
+	Template    string // path to a custom template file.
+	Package     string // output package directory, relative to the package directory.
+}
+
+// isExported reports whether the mock is exported.
+// The mocks generated in another package are always exported.
+func (o directiveOptions) isExported(exported bool) bool {
+	if o.Exported != nil {
+		return *o.Exported
+	}
+
+	return exported || o.Package != ""
+}
+
+// getPackage returns the output package directory of the mock.
+// The default output package only applies to the exported mocks.
+func (o directiveOptions) getPackage(exported bool, defaultPackage string) string {
+	if o.Package != "" || !exported {
+		return o.Package
+	}
+
+	return defaultPackage
+}
+
+// parseDirective parses the text following the `// mocktail:` prefix.
+func parseDirective(pos token.Position, text string) (directive, error) {
+	fields := splitDirective(text)
+	if len(fields) == 0 {
+		return directive{}, fmt.Errorf("%s: missing interface name", pos)
+	}
+
+	d := directive{Pos: pos, Target: fields[0]}
+
+	for _, field := range fields[1:] {
+		key, value, hasValue := strings.Cut(field, "=")
+
+		if hasValue && value == "" {
+			return directive{}, fmt.Errorf("%s: empty value for option %q", pos, key)
+		}
+
+		switch key {
+		case "name":
+			d.Options.Name = value
+
+		case "constructor":
+			d.Options.Constructor = value
+
+		case "file":
+			d.Options.File = value
+
+		case "template":
+			d.Options.Template = value
+
+		case "package":
+			d.Options.Package = value
+
+		case "exported", "unexported":
+			exported := key == "exported"
+
+			if hasValue {
+				v, err := strconv.ParseBool(value)
+				if err != nil {
+					return directive{}, fmt.Errorf("%s: invalid value for option %q: %w", pos, key, err)
+				}
+
+				exported = exported == v
+			}
+
+			d.Options.Exported = &exported
+
+			continue
+
+		default:
+			return directive{}, fmt.Errorf("%s: unknown option %q", pos, key)
+		}
+
+		if !hasValue {
+			return directive{}, fmt.Errorf("%s: missing value for option %q", pos, key)
+		}
+	}
+
+	err := d.validate()
+	if err != nil {
+		return directive{}, err
+	}
+
+	return d, nil
+}
+
+// validate checks the target pattern and the options.
+func (d directive) validate() error {
+	if d.Target == "" {
+		return fmt.Errorf("%s: missing interface name", d.Pos)
+	}
+
+	target, typeArgs := splitTypeArgs(d.Target)
+
+	_, name := splitTarget(target)
+	if isNamePattern(name) && typeArgs != "" {
+		return fmt.Errorf("%s: type arguments are not allowed with a pattern: %q", d.Pos, d.Target)
+	}
+
+	if isNamePattern(name) && name != "*" {
+		_, err := regexp.Compile(name[1 : len(name)-1])
+		if err != nil {
+			return fmt.Errorf("%s: invalid pattern %q: %w", d.Pos, name, err)
+		}
+	}
+
+	if d.Options.Package != "" && d.Options.Exported != nil && !*d.Options.Exported {
+		return fmt.Errorf("%s: the mocks generated in another package must be exported", d.Pos)
+	}
+
+	file := d.Options.File
+	if file != "" && (filepath.Base(file) != file || !strings.HasSuffix(file, ".go")) {
+		return fmt.Errorf("%s: invalid file name %q: must be a Go file name without directory", d.Pos, file)
+	}
+
+	// The file containing the directive would be replaced by the mocks.
+	if file != "" && d.Options.Package == "" && file == filepath.Base(d.Pos.Filename) {
+		return fmt.Errorf("%s: invalid file name %q: the mocks cannot be generated in the file of the directive", d.Pos, file)
+	}
+
+	return nil
+}
+
+// parseExclusion parses the text following the `// mocktail-:` prefix.
+// The options are ignored.
+func parseExclusion(pos token.Position, text string) (directive, error) {
+	fields := splitDirective(text)
+	if len(fields) == 0 {
+		return directive{}, fmt.Errorf("%s: missing interface name", pos)
+	}
+
+	return directive{Pos: pos, Target: fields[0], Exclude: true}, nil
+}
+
+// isNamePattern reports whether the name part of a target is a pattern (`*`, `/regexp/`).
+func isNamePattern(name string) bool {
+	return name == "*" || len(name) > 1 && strings.HasPrefix(name, "/") && strings.HasSuffix(name, "/")
+}
+
+// newNameMatcher returns a function matching the type names against the name part of a target.
+// The regular expressions must be validated before.
+func newNameMatcher(name string) func(string) bool {
+	switch {
+	case name == "*":
+		return func(string) bool { return true }
+
+	case isNamePattern(name):
+		return regexp.MustCompile(name[1 : len(name)-1]).MatchString
+
+	default:
+		return func(s string) bool { return s == name }
+	}
+}
+
+// splitDirective splits the directive text around spaces, except inside brackets and braces.
+func splitDirective(text string) []string {
+	var fields []string
+
+	var depth int
+
+	start := -1
+
+	for i, r := range text {
+		switch {
+		case r == '[' || r == '{':
+			depth++
+
+		case r == ']' || r == '}':
+			depth--
+
+		case unicode.IsSpace(r) && depth <= 0:
+			if start >= 0 {
+				fields = append(fields, text[start:i])
+				start = -1
+			}
+
+			continue
+		}
+
+		if start < 0 {
+			start = i
+		}
+	}
+
+	if start >= 0 {
+		fields = append(fields, text[start:])
+	}
+
+	return fields
+}
+
+// collectDirectives walks the module and returns the directives grouped by package directory.
+// The directives of a package are ordered by file name, then by line.
+// The valid directives are returned with the errors of the invalid directives of all the files.
+// The nested modules, and the files ignored by the matcher, are skipped.
+func collectDirectives(root string, exported bool, ignore *ignoreMatcher) (map[string][]directive, error) {
+	directives := make(map[string][]directive)
+
+	var errs []error
+
+	err := walkModule(root, ignore, func(fp string, d fs.DirEntry) error {
+		if !isDirectiveFile(d.Name(), exported) {
+			return nil
+		}
+
+		fileDirectives, err := scanDirectives(fp)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		if len(fileDirectives) == 0 {
+			return nil
+		}
+
+		dir := filepath.Dir(fp)
+
+		directives[dir] = append(directives[dir], fileDirectives...)
+
+		return nil
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("walk dir: %w", err)
+	}
+
+	return directives, errors.Join(errs...)
+}
+
+// isDirectiveFile reports whether the file can contain directives.
+// Test files are always scanned, other Go files only for exported mocks.
+func isDirectiveFile(name string, exported bool) bool {
+	if name == outputMockFile || name == outputExportedMockFile {
+		return false
+	}
+
+	if strings.HasSuffix(name, "_test.go") {
+		return true
+	}
+
+	return exported && strings.HasSuffix(name, ".go")
+}
+
+func scanDirectives(fp string) ([]directive, error) {
+	file, err := os.Open(fp)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = file.Close() }()
+
+	var directives []directive
+
+	var errs []error
+
+	var lineNum int
+
+	var header buildConstraintParser
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lineNum++
+
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		pos := token.Position{Filename: fp, Line: lineNum}
+
+		err = header.parseLine(line)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", pos, err))
+		}
+
+		var d directive
+
+		// Only the comment lines are directives: a tag inside a string or after code is ignored.
+		trimmed := strings.TrimSpace(line)
+
+		if text, ok := strings.CutPrefix(trimmed, commentTagPattern); ok {
+			d, err = parseDirective(pos, text)
+		} else if text, ok := strings.CutPrefix(trimmed, excludeTagPattern); ok {
+			d, err = parseExclusion(pos, text)
+		} else {
+			continue
+		}
+
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		directives = append(directives, d)
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("scan %s: %w", fp, err)
+	}
+
+	// The mocks are only built when the directives are.
+	expr := fileConstraint(header.expr(), filepath.Base(fp))
+	for i := range directives {
+		directives[i].Constraint = expr
+	}
+
+	return directives, errors.Join(errs...)
+}
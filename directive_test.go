@@ -0,0 +1,234 @@
+package main
+
+import (
+	"go/token"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_isDirectiveFile(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		name     string
+		exported bool
+		expected bool
+	}{
+		{desc: "mock file", name: "mock_test.go", expected: true},
+		{desc: "test file", name: "foo_test.go", expected: true},
+		{desc: "source file", name: "foo.go", expected: false},
+		{desc: "source file exported", name: "foo.go", exported: true, expected: true},
+		{desc: "generated file", name: outputMockFile, expected: false},
+		{desc: "generated exported file", name: outputExportedMockFile, exported: true, expected: false},
+		{desc: "not a Go file", name: "foo.txt", exported: true, expected: false},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, isDirectiveFile(test.name, test.exported))
+		})
+	}
+}
+
+func Test_parseDirective(t *testing.T) {
+	pos := token.Position{Filename: "a/mock_test.go", Line: 12}
+
+	testCases := []struct {
+		desc     string
+		text     string
+		expected directive
+	}{
+		{
+			desc:     "interface name only",
+			text:     "Foo",
+			expected: directive{Pos: pos, Target: "Foo"},
+		},
+		{
+			desc:     "trailing spaces",
+			text:     "b.Foo  ",
+			expected: directive{Pos: pos, Target: "b.Foo"},
+		},
+		{
+			desc: "all options",
+			text: "Foo name=fooStub constructor=newFooStub exported file=foo_mock_test.go template=foo.tmpl",
+			expected: directive{Pos: pos, Target: "Foo", Options: directiveOptions{
+				Name:        "fooStub",
+				Constructor: "newFooStub",
+				Exported:    ptr(true),
+				File:        "foo_mock_test.go",
+				Template:    "foo.tmpl",
+			}},
+		},
+		{
+			desc:     "unexported",
+			text:     "Foo unexported",
+			expected: directive{Pos: pos, Target: "Foo", Options: directiveOptions{Exported: ptr(false)}},
+		},
+		{
+			desc:     "exported with value",
+			text:     "Foo exported=false",
+			expected: directive{Pos: pos, Target: "Foo", Options: directiveOptions{Exported: ptr(false)}},
+		},
+		{
+			desc:     "unexported with value",
+			text:     "Foo unexported=false",
+			expected: directive{Pos: pos, Target: "Foo", Options: directiveOptions{Exported: ptr(true)}},
+		},
+		{
+			desc:     "brackets",
+			text:     "Foo[User, int] name=userFooMock",
+			expected: directive{Pos: pos, Target: "Foo[User, int]", Options: directiveOptions{Name: "userFooMock"}},
+		},
+		{
+			desc:     "package pattern",
+			text:     "Foo package=../{{ .PackageName }}mock",
+			expected: directive{Pos: pos, Target: "Foo", Options: directiveOptions{Package: "../{{ .PackageName }}mock"}},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := parseDirective(pos, test.text)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, d)
+		})
+	}
+}
+
+func Test_parseDirective_error(t *testing.T) {
+	pos := token.Position{Filename: "a/mock_test.go", Line: 12}
+
+	testCases := []struct {
+		desc     string
+		text     string
+		expected string
+	}{
+		{
+			desc:     "empty",
+			text:     " ",
+			expected: "a/mock_test.go:12: missing interface name",
+		},
+		{
+			desc:     "unknown option",
+			text:     "Foo foo=bar",
+			expected: `a/mock_test.go:12: unknown option "foo"`,
+		},
+		{
+			desc:     "missing value",
+			text:     "Foo name",
+			expected: `a/mock_test.go:12: missing value for option "name"`,
+		},
+		{
+			desc:     "empty value",
+			text:     "Foo name=",
+			expected: `a/mock_test.go:12: empty value for option "name"`,
+		},
+		{
+			desc:     "invalid boolean",
+			text:     "Foo exported=yes",
+			expected: `a/mock_test.go:12: invalid value for option "exported": strconv.ParseBool: parsing "yes": invalid syntax`,
+		},
+		{
+			desc:     "invalid pattern",
+			text:     "store./(/",
+			expected: "a/mock_test.go:12: invalid pattern \"/(/\": error parsing regexp: missing closing ): `(`",
+		},
+		{
+			desc:     "pattern with type arguments",
+			text:     "store.*[User]",
+			expected: `a/mock_test.go:12: type arguments are not allowed with a pattern: "store.*[User]"`,
+		},
+		{
+			desc:     "unexported in another package",
+			text:     "Foo package=foomock unexported",
+			expected: "a/mock_test.go:12: the mocks generated in another package must be exported",
+		},
+		{
+			desc:     "file with directory",
+			text:     "Foo file=foo/mock_test.go",
+			expected: `a/mock_test.go:12: invalid file name "foo/mock_test.go": must be a Go file name without directory`,
+		},
+		{
+			desc:     "file of the directive",
+			text:     "Foo file=mock_test.go",
+			expected: `a/mock_test.go:12: invalid file name "mock_test.go": the mocks cannot be generated in the file of the directive`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseDirective(pos, test.text)
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func Test_scanDirectives(t *testing.T) {
+	fp := filepath.Join(t.TempDir(), "a_test.go")
+
+	writeFile(t, fp, `package a
+
+// mocktail:Foo
+	// mocktail-:Bar
+
+func TestFoo(t *testing.T) {
+	t.Log("see // mocktail:Foo for details")
+
+	_ = 1 // mocktail:Baz unknown
+}
+`)
+
+	directives, err := scanDirectives(fp)
+	require.NoError(t, err)
+
+	require.Len(t, directives, 2)
+	assert.Equal(t, "Foo", directives[0].Target)
+	assert.Equal(t, 3, directives[0].Pos.Line)
+	assert.Equal(t, "Bar", directives[1].Target)
+	assert.True(t, directives[1].Exclude)
+}
+
+func Test_parseExclusion(t *testing.T) {
+	pos := token.Position{Filename: "a/mock_test.go", Line: 12}
+
+	d, err := parseExclusion(pos, "fmt.Stringer name=foo")
+	require.NoError(t, err)
+
+	assert.Equal(t, directive{Pos: pos, Target: "fmt.Stringer", Exclude: true}, d)
+}
+
+func Test_newNameMatcher(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{desc: "name", pattern: "UserRepo", name: "UserRepo", expected: true},
+		{desc: "other name", pattern: "UserRepo", name: "OrderRepo", expected: false},
+		{desc: "wildcard", pattern: "*", name: "UserRepo", expected: true},
+		{desc: "regexp", pattern: "/.*Repo$/", name: "UserRepo", expected: true},
+		{desc: "regexp not matching", pattern: "/.*Repo$/", name: "RepoCache", expected: false},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, newNameMatcher(test.pattern)(test.name))
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
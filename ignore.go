@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const gitignoreFileName = ".gitignore"
+
+// ignoreRule is a rule of a `.gitignore` file.
+type ignoreRule struct {
+	base     string // directory of the `.gitignore`, relative to the repository root.
+	pattern  string
+	negate   bool
+	dirOnly  bool
+	anchored bool // the pattern contains a slash: it is matched against the path relative to the base.
+}
+
+// ignoreMatcher matches the files and directories excluded by the globs (`-exclude`) or by the `.gitignore` files.
+// The paths of the globs are relative to the root, the paths of the rules to the repository root, with slashes.
+type ignoreMatcher struct {
+	root     string
+	repo     string // root of the git repository, or the root outside a repository.
+	excludes []string
+	rules    []ignoreRule
+}
+
+func newIgnoreMatcher(root string, excludes []string) *ignoreMatcher {
+	return &ignoreMatcher{root: root, repo: root, excludes: excludes}
+}
+
+// loadParents reads the `.gitignore` files of the parent directories of the root, up to the root of the git repository.
+// It must be called before the other directories are loaded. Nothing is loaded outside a git repository.
+func (m *ignoreMatcher) loadParents() error {
+	if !filepath.IsAbs(m.root) {
+		return nil
+	}
+
+	repo, ok := findRepositoryRoot(m.root)
+	if !ok || repo == m.root {
+		return nil
+	}
+
+	m.repo = repo
+
+	var dirs []string
+
+	for dir := filepath.Dir(m.root); ; dir = filepath.Dir(dir) {
+		dirs = append(dirs, dir)
+
+		if dir == repo {
+			break
+		}
+	}
+
+	for _, dir := range slices.Backward(dirs) {
+		err := m.load(dir)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findRepositoryRoot returns the first directory containing a `.git` entry (directory, or file of a worktree), from a directory to its parents.
+func findRepositoryRoot(dir string) (string, bool) {
+	for {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return dir, true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+
+		dir = parent
+	}
+}
+
+// load reads the `.gitignore` file of a directory, if any.
+// The directories must be loaded from the root to the leaves.
+func (m *ignoreMatcher) load(dir string) error {
+	file, err := os.Open(filepath.Join(dir, gitignoreFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = file.Close() }()
+
+	base, err := relPath(m.repo, dir)
+	if err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		rule, ok := parseIgnoreRule(base, scanner.Text())
+		if ok {
+			m.rules = append(m.rules, rule)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// match reports whether a file or a directory is ignored.
+func (m *ignoreMatcher) match(fp string, isDir bool) bool {
+	rel, err := relPath(m.root, fp)
+	if err != nil || rel == "" {
+		return false
+	}
+
+	for _, glob := range m.excludes {
+		if matchGlob(glob, rel) || !strings.Contains(glob, "/") && matchGlob(glob, path.Base(rel)) {
+			return true
+		}
+	}
+
+	rel, err = relPath(m.repo, fp)
+	if err != nil {
+		return false
+	}
+
+	// The last matching rule wins.
+	var ignored bool
+
+	for _, rule := range m.rules {
+		if rule.match(rel, isDir) {
+			ignored = !rule.negate
+		}
+	}
+
+	return ignored
+}
+
+// relPath returns the path of a file relative to a directory, with slashes, empty for the directory itself.
+func relPath(dir, fp string) (string, error) {
+	rel, err := filepath.Rel(dir, fp)
+	if err != nil {
+		return "", err
+	}
+
+	if rel == "." {
+		return "", nil
+	}
+
+	return filepath.ToSlash(rel), nil
+}
+
+func parseIgnoreRule(base, line string) (ignoreRule, bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ignoreRule{}, false
+	}
+
+	rule := ignoreRule{base: base}
+
+	if strings.HasPrefix(line, "!") {
+		rule.negate = true
+		line = line[1:]
+	}
+
+	// Escaped leading characters.
+	line = strings.TrimPrefix(line, `\`)
+
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimSuffix(line, "/")
+	}
+
+	if strings.Contains(line, "/") {
+		rule.anchored = true
+		line = strings.TrimPrefix(line, "/")
+	}
+
+	if line == "" {
+		return ignoreRule{}, false
+	}
+
+	rule.pattern = line
+
+	return rule, true
+}
+
+func (r ignoreRule) match(rel string, isDir bool) bool {
+	if r.dirOnly && !isDir {
+		return false
+	}
+
+	if r.base != "" {
+		if !strings.HasPrefix(rel, r.base+"/") {
+			return false
+		}
+
+		rel = strings.TrimPrefix(rel, r.base+"/")
+	}
+
+	if r.anchored {
+		return matchGlob(r.pattern, rel)
+	}
+
+	return matchGlob(r.pattern, path.Base(rel))
+}
+
+// matchGlob matches a slash-separated path against a glob (`path.Match` syntax).
+// The segment `**` matches zero or more directories.
+func matchGlob(glob, name string) bool {
+	return matchSegments(strings.Split(glob, "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(globs, names []string) bool {
+	for len(globs) > 0 {
+		if globs[0] == "**" {
+			for i := range len(names) + 1 {
+				if matchSegments(globs[1:], names[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(names) == 0 {
+			return false
+		}
+
+		ok, err := path.Match(globs[0], names[0])
+		if err != nil || !ok {
+			return false
+		}
+
+		globs, names = globs[1:], names[1:]
+	}
+
+	return len(names) == 0
+}
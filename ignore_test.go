@@ -0,0 +1,121 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_matchGlob(t *testing.T) {
+	testCases := []struct {
+		glob     string
+		name     string
+		expected bool
+	}{
+		{glob: "gen", name: "gen", expected: true},
+		{glob: "gen", name: "a/gen", expected: false},
+		{glob: "*_test.go", name: "mock_test.go", expected: true},
+		{glob: "a/*", name: "a/b", expected: true},
+		{glob: "a/*", name: "a/b/c", expected: false},
+		{glob: "**/gen", name: "gen", expected: true},
+		{glob: "**/gen", name: "a/b/gen", expected: true},
+		{glob: "a/**", name: "a/b/c", expected: true},
+		{glob: "a/**/c", name: "a/c", expected: true},
+		{glob: "a/**/c", name: "a/b/d", expected: false},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.glob+" "+test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, matchGlob(test.glob, test.name))
+		})
+	}
+}
+
+func Test_ignoreMatcher(t *testing.T) {
+	root := t.TempDir()
+
+	writeFile(t, filepath.Join(root, gitignoreFileName), "# comment\n\n/build/\n*.tmp\nlogs\n!keep.tmp\n")
+	writeFile(t, filepath.Join(root, "a", gitignoreFileName), "b/c\n")
+
+	ignore := newIgnoreMatcher(root, []string{"generated", "x/**/y"})
+	require.NoError(t, ignore.load(root))
+	require.NoError(t, ignore.load(filepath.Join(root, "a")))
+
+	testCases := []struct {
+		desc     string
+		path     string
+		isDir    bool
+		expected bool
+	}{
+		{desc: "root", path: "", isDir: true},
+		{desc: "anchored directory", path: "build", isDir: true, expected: true},
+		{desc: "anchored directory as a file", path: "build"},
+		{desc: "anchored directory in a sub-directory", path: "a/build", isDir: true},
+		{desc: "file pattern", path: "a/b/foo.tmp", expected: true},
+		{desc: "name", path: "a/logs", isDir: true, expected: true},
+		{desc: "negation", path: "a/keep.tmp", expected: false},
+		{desc: "nested gitignore", path: "a/b/c", isDir: true, expected: true},
+		{desc: "nested gitignore outside its directory", path: "b/c", isDir: true},
+		{desc: "exclude name", path: "a/generated", isDir: true, expected: true},
+		{desc: "exclude path", path: "x/1/2/y", isDir: true, expected: true},
+		{desc: "not ignored", path: "a/b/mock_test.go"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, ignore.match(filepath.Join(root, filepath.FromSlash(test.path)), test.isDir))
+		})
+	}
+}
+
+func Test_collectDirectives_ignored(t *testing.T) {
+	root := t.TempDir()
+
+	writeFile(t, filepath.Join(root, "go.mod"), "module a\n\ngo 1.24\n")
+	writeFile(t, filepath.Join(root, gitignoreFileName), "ignored/\n")
+	writeFile(t, filepath.Join(root, "a", "mock_test.go"), "package a\n\n// mocktail:Foo\n")
+	writeFile(t, filepath.Join(root, "ignored", "mock_test.go"), "package ignored\n\n// mocktail:Foo\n")
+	writeFile(t, filepath.Join(root, "excluded", "mock_test.go"), "package excluded\n\n// mocktail:Foo\n")
+	writeFile(t, filepath.Join(root, "nested", "go.mod"), "module nested\n\ngo 1.24\n")
+	writeFile(t, filepath.Join(root, "nested", "mock_test.go"), "package nested\n\n// mocktail:Foo\n")
+
+	directives, err := collectDirectives(root, false, newIgnoreMatcher(root, []string{"excluded"}))
+	require.NoError(t, err)
+
+	assert.Len(t, directives, 1)
+	assert.Contains(t, directives, filepath.Join(root, "a"))
+
+	modules, err := findNestedModules(root, nil)
+	require.NoError(t, err)
+
+	require.Len(t, modules, 1)
+	assert.Equal(t, "nested", modules[0].Path)
+	assert.Equal(t, filepath.Join(root, "nested"), modules[0].Dir)
+}
+
+func Test_collectDirectives_parentGitignore(t *testing.T) {
+	repo := t.TempDir()
+
+	writeFile(t, filepath.Join(repo, ".git", "HEAD"), "ref: refs/heads/main\n")
+	writeFile(t, filepath.Join(repo, gitignoreFileName), "generated/\n")
+	writeFile(t, filepath.Join(repo, "mods", gitignoreFileName), "a/skipped\n")
+
+	root := filepath.Join(repo, "mods", "a")
+
+	writeFile(t, filepath.Join(root, "go.mod"), "module a\n\ngo 1.24\n")
+	writeFile(t, filepath.Join(root, "b", "mock_test.go"), "package b\n\n// mocktail:Foo\n")
+	writeFile(t, filepath.Join(root, "generated", "mock_test.go"), "package generated\n\n// mocktail:Foo\n")
+	writeFile(t, filepath.Join(root, "skipped", "mock_test.go"), "package skipped\n\n// mocktail:Foo\n")
+
+	directives, err := collectDirectives(root, false, newIgnoreMatcher(root, nil))
+	require.NoError(t, err)
+
+	assert.Len(t, directives, 1)
+	assert.Contains(t, directives, filepath.Join(root, "b"))
+}
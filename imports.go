@@ -0,0 +1,244 @@
+package main
+
+import (
+	"go/types"
+	"maps"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// requiredImports are the packages used by the template, with their names.
+var requiredImports = []struct{ path, name string }{
+	{path: "github.com/stretchr/testify/mock", name: "mock"},
+	{path: "testing", name: "testing"},
+	{path: "time", name: "time"},
+}
+
+// templateIdentifiers are the identifiers declared by the template inside the functions using the imported types.
+var templateIdentifiers = []string{"_c", "_m", "_rf", "_ret", "args", "d", "fn", "i", "m", "msg", "ok", "tb", "w"}
+
+// importRegistry assigns unique names to the packages imported by a generated file.
+// The names are deterministic: the packages are sorted by path, and the conflicting names get a numeric suffix.
+type importRegistry struct {
+	names map[string]string // by import path.
+}
+
+// newImportRegistry creates the registry of the packages used by the mocks of a file.
+// The names of the packages cannot conflict with the required imports, the identifiers of the template,
+// and the names of the parameters and results of the methods.
+func newImportRegistry(pkgDesc PackageDesc) *importRegistry {
+	r := &importRegistry{names: make(map[string]string)}
+
+	used := make(map[string]bool)
+
+	for _, imp := range requiredImports {
+		r.names[imp.path] = imp.name
+		used[imp.name] = true
+	}
+
+	for _, name := range templateIdentifiers {
+		used[name] = true
+	}
+
+	pkgs := make(map[string]*types.Package)
+
+	for _, interfaceDesc := range pkgDesc.Interfaces {
+		collectInterfacePackages(interfaceDesc, pkgs)
+
+		for _, method := range interfaceDesc.Methods {
+			signature := method.Signature()
+
+			for i := range signature.Params().Len() {
+				name := getParamName(signature.Params().At(i), i)
+				used[name] = true
+				used["_"+name] = true
+			}
+
+			for i := range signature.Results().Len() {
+				used[getResultName(signature.Results().At(i), i)] = true
+			}
+		}
+	}
+
+	for _, pkgPath := range slices.Sorted(maps.Keys(pkgs)) {
+		if _, ok := r.names[pkgPath]; ok || pkgPath == pkgDesc.Pkg.Path() {
+			continue
+		}
+
+		name := pkgs[pkgPath].Name()
+		for i := 2; used[name]; i++ {
+			name = pkgs[pkgPath].Name() + strconv.Itoa(i)
+		}
+
+		r.names[pkgPath] = name
+		used[name] = true
+	}
+
+	return r
+}
+
+// name returns the name of an imported package.
+func (r *importRegistry) name(pkg *types.Package) string {
+	if r != nil {
+		if name, ok := r.names[pkg.Path()]; ok {
+			return name
+		}
+	}
+
+	return pkg.Name()
+}
+
+// aliases returns the explicit names of the imports:
+// the names that cannot be deduced from the import paths (ex: `a/e/v2`, or conflicting names).
+func (r *importRegistry) aliases() map[string]string {
+	aliases := make(map[string]string)
+
+	if r == nil {
+		return aliases
+	}
+
+	for pkgPath, name := range r.names {
+		if name != importPathToAssumedName(pkgPath) {
+			aliases[pkgPath] = name
+		}
+	}
+
+	return aliases
+}
+
+// importPathToAssumedName returns the package name assumed from an import path (same rules as goimports):
+// the last element of the path, without the major version suffix, the `go-` prefix, and the characters after the first invalid character.
+func importPathToAssumedName(importPath string) string {
+	base := path.Base(importPath)
+
+	if strings.HasPrefix(base, "v") {
+		if _, err := strconv.Atoi(base[1:]); err == nil {
+			dir := path.Dir(importPath)
+			if dir != "." {
+				base = path.Base(dir)
+			}
+		}
+	}
+
+	base = strings.TrimPrefix(base, "go-")
+
+	if i := strings.IndexFunc(base, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' }); i >= 0 {
+		base = base[:i]
+	}
+
+	return base
+}
+
+// collectInterfacePackages collects the packages used by a mock: its function type (accessor `Func()`),
+// the constraints of its type parameters, and the signatures of its methods.
+func collectInterfacePackages(interfaceDesc InterfaceDesc, pkgs map[string]*types.Package) {
+	if interfaceDesc.FuncType != nil {
+		collectPackages(interfaceDesc.FuncType, pkgs)
+	}
+
+	if interfaceDesc.TypeParams != nil {
+		for tp := range interfaceDesc.TypeParams.TypeParams() {
+			collectPackages(tp.Constraint(), pkgs)
+		}
+	}
+
+	for _, method := range interfaceDesc.Methods {
+		collectPackages(method.Signature(), pkgs)
+	}
+}
+
+// collectPackages collects the packages of the named types used by a type.
+func collectPackages(t types.Type, pkgs map[string]*types.Package) {
+	walkTypeObjects(t, func(obj types.Object) bool {
+		if _, ok := obj.(*types.TypeName); ok && obj.Pkg() != nil {
+			pkgs[obj.Pkg().Path()] = obj.Pkg()
+		}
+
+		return true
+	})
+}
+
+// walkTypeObjects calls fn with the objects used by the written form of a type:
+// the named types and the aliases, the fields of the anonymous structs, and the methods of the interface literals.
+// The constraints of the type parameters are not walked. The walk stops when fn returns false.
+func walkTypeObjects(t types.Type, fn func(types.Object) bool) bool {
+	switch v := t.(type) {
+	case *types.Named:
+		if !fn(v.Obj()) {
+			return false
+		}
+
+		for arg := range v.TypeArgs().Types() {
+			if !walkTypeObjects(arg, fn) {
+				return false
+			}
+		}
+
+	case *types.Alias:
+		if !fn(v.Obj()) {
+			return false
+		}
+
+		for arg := range v.TypeArgs().Types() {
+			if !walkTypeObjects(arg, fn) {
+				return false
+			}
+		}
+
+	case *types.Pointer:
+		return walkTypeObjects(v.Elem(), fn)
+
+	case *types.Slice:
+		return walkTypeObjects(v.Elem(), fn)
+
+	case *types.Array:
+		return walkTypeObjects(v.Elem(), fn)
+
+	case *types.Chan:
+		return walkTypeObjects(v.Elem(), fn)
+
+	case *types.Map:
+		return walkTypeObjects(v.Key(), fn) && walkTypeObjects(v.Elem(), fn)
+
+	case *types.Signature:
+		for _, tuple := range []*types.Tuple{v.Params(), v.Results()} {
+			for param := range tuple.Variables() {
+				if !walkTypeObjects(param.Type(), fn) {
+					return false
+				}
+			}
+		}
+
+	case *types.Struct:
+		for field := range v.Fields() {
+			if !fn(field) || !walkTypeObjects(field.Type(), fn) {
+				return false
+			}
+		}
+
+	case *types.Interface:
+		for embedded := range v.EmbeddedTypes() {
+			if !walkTypeObjects(embedded, fn) {
+				return false
+			}
+		}
+
+		for method := range v.ExplicitMethods() {
+			if !fn(method) || !walkTypeObjects(method.Type(), fn) {
+				return false
+			}
+		}
+
+	case *types.Union:
+		for term := range v.Terms() {
+			if !walkTypeObjects(term.Type(), fn) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
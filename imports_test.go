@@ -0,0 +1,80 @@
+package main
+
+import (
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_importPathToAssumedName(t *testing.T) {
+	testCases := []struct {
+		importPath string
+		expected   string
+	}{
+		{importPath: "context", expected: "context"},
+		{importPath: "github.com/x/log", expected: "log"},
+		{importPath: "a/e/v2", expected: "e"},
+		{importPath: "gopkg.in/yaml.v3", expected: "yaml"},
+		{importPath: "github.com/x/go-difflib", expected: "difflib"},
+		{importPath: "v2", expected: "v2"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.importPath, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, importPathToAssumedName(test.importPath))
+		})
+	}
+}
+
+func Test_newImportRegistry(t *testing.T) {
+	xlog := types.NewPackage("github.com/x/log", "log")
+	ylog := types.NewPackage("github.com/y/log", "log")
+	e := types.NewPackage("a/e/v2", "e")
+	mockPkg := types.NewPackage("a/mock", "mock")
+	user := types.NewPackage("a/user", "user")
+	self := types.NewPackage("a/store", "store")
+
+	named := func(pkg *types.Package, name string) *types.Named {
+		return types.NewNamed(types.NewTypeName(0, pkg, name, nil), types.Typ[types.Int], nil)
+	}
+
+	params := types.NewTuple(
+		types.NewParam(0, nil, "entry", named(ylog, "Entry")),
+		types.NewParam(0, nil, "level", named(xlog, "Level")),
+		types.NewParam(0, nil, "user", named(user, "User")),
+		types.NewParam(0, nil, "value", types.NewSlice(named(mockPkg, "Value"))),
+		types.NewParam(0, nil, "store", named(self, "Store")),
+	)
+	results := types.NewTuple(types.NewParam(0, nil, "", named(e, "Event")))
+
+	method := types.NewFunc(0, self, "Write", types.NewSignatureType(nil, nil, nil, params, results, false))
+
+	registry := newImportRegistry(PackageDesc{
+		Pkg:        self,
+		Interfaces: []InterfaceDesc{{Name: "Logger", Methods: []*types.Func{method}}},
+	})
+
+	assert.Equal(t, "log", registry.name(xlog))
+	assert.Equal(t, "log2", registry.name(ylog))
+	assert.Equal(t, "e", registry.name(e))
+	assert.Equal(t, "mock2", registry.name(mockPkg))
+	assert.Equal(t, "user2", registry.name(user))
+	assert.Equal(t, "mock", registry.name(types.NewPackage("github.com/stretchr/testify/mock", "mock")))
+
+	expected := map[string]string{
+		"github.com/y/log": "log2",
+		"a/mock":           "mock2",
+		"a/user":           "user2",
+	}
+
+	assert.Equal(t, expected, registry.aliases())
+
+	// Without registry.
+	var empty *importRegistry
+
+	assert.Equal(t, "log", empty.name(ylog))
+	assert.Empty(t, empty.aliases())
+}
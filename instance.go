@@ -0,0 +1,360 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ettle/strcase"
+	"golang.org/x/tools/go/packages"
+)
+
+// splitTypeArgs splits a directive target into the generic type and its type arguments.
+//
+//	Repository[User, int] -> Repository, User, int
+func splitTypeArgs(target string) (string, string) {
+	index := strings.Index(target, "[")
+	if index <= 0 || !strings.HasSuffix(target, "]") {
+		return target, ""
+	}
+
+	return target[:index], strings.TrimSpace(target[index+1 : len(target)-1])
+}
+
+// instantiate instantiates a generic type with the type arguments of a directive (ex: `User, int` for `Repository[User, int]`).
+// The type arguments are resolved inside the package of the directive, then inside the package of the generic type.
+// The package qualifiers are resolved with the imports of the file of the directive (import path -> explicit name) first.
+func instantiate(obj *types.TypeName, typeArgs string, pkgs map[string]*packages.Package, pkgPath string, imports map[string]string) (types.Type, error) {
+	expr, err := parser.ParseExpr("_[" + typeArgs + "]")
+	if err != nil {
+		return nil, fmt.Errorf("invalid type arguments %q: %w", typeArgs, err)
+	}
+
+	var exprs []ast.Expr
+
+	switch e := expr.(type) {
+	case *ast.IndexExpr:
+		exprs = []ast.Expr{e.Index}
+	case *ast.IndexListExpr:
+		exprs = e.Indices
+	default:
+		return nil, fmt.Errorf("invalid type arguments %q", typeArgs)
+	}
+
+	resolver := newTypeResolver(obj.Pkg(), pkgs, pkgPath, imports)
+
+	var args []types.Type
+
+	for _, e := range exprs {
+		arg, err := resolver.resolve(e)
+		if err != nil {
+			return nil, err
+		}
+
+		args = append(args, arg)
+	}
+
+	inst, err := types.Instantiate(nil, obj.Type(), args, true)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate %s[%s]: %w", obj.Name(), typeArgs, err)
+	}
+
+	// The instance of a generic alias is an alias of an instantiated named type.
+	if _, ok := types.Unalias(inst).(*types.Named); !ok {
+		return nil, fmt.Errorf("%s is not a named type", obj.Name())
+	}
+
+	return inst, nil
+}
+
+// getInstanceName returns the name of an instantiated generic type, prefixed by the names of all its type arguments.
+//
+//	Repository[User, int] -> UserIntRepository
+//	Repository[[]store.User, *Page[User]] -> UserSliceUserPagePtrRepository
+func getInstanceName(instance types.Type) string {
+	var name string
+
+	for arg := range getTypeArgList(instance).Types() {
+		name += getTypeArgName(arg)
+	}
+
+	return name + getTypeObject(instance).Name()
+}
+
+// getTypeArgName returns the part of the name of an instance for a type argument (ex: `map[string]int` -> `StringIntMap`).
+func getTypeArgName(t types.Type) string {
+	switch v := t.(type) {
+	case *types.Named, *types.Alias:
+		return strcase.ToGoPascal(getInstanceName(v))
+
+	case *types.Basic:
+		return strcase.ToGoPascal(v.Name())
+
+	case *types.Pointer:
+		return getTypeArgName(v.Elem()) + "Ptr"
+
+	case *types.Slice:
+		return getTypeArgName(v.Elem()) + "Slice"
+
+	case *types.Array:
+		return getTypeArgName(v.Elem()) + "Array"
+
+	case *types.Map:
+		return getTypeArgName(v.Key()) + getTypeArgName(v.Elem()) + "Map"
+
+	case *types.Chan:
+		return getTypeArgName(v.Elem()) + "Chan"
+
+	case *types.Interface:
+		return "Any"
+
+	case *types.TypeParam:
+		return strcase.ToGoPascal(v.Obj().Name())
+
+	default:
+		return ""
+	}
+}
+
+// getTypeObject returns the object of a named type or of an alias, nil for other types.
+func getTypeObject(t types.Type) *types.TypeName {
+	switch v := t.(type) {
+	case *types.Named:
+		return v.Obj()
+	case *types.Alias:
+		return v.Obj()
+	default:
+		return nil
+	}
+}
+
+// getTypeArgList returns the type arguments of a named type or of an alias, nil for other types.
+func getTypeArgList(t types.Type) *types.TypeList {
+	switch v := t.(type) {
+	case *types.Named:
+		return v.TypeArgs()
+	case *types.Alias:
+		return v.TypeArgs()
+	default:
+		return nil
+	}
+}
+
+// getTypeArgs returns the type arguments of an instantiated type (ex: `[User, int]`).
+func (s Syrup) getTypeArgs(list *types.TypeList) string {
+	if list.Len() == 0 {
+		return ""
+	}
+
+	var args []string
+	for arg := range list.Types() {
+		args = append(args, s.getTypeName(arg, false))
+	}
+
+	return "[" + strings.Join(args, ", ") + "]"
+}
+
+// getFileImports returns the imports of a Go file: the explicit names by import path, an empty name for the package name.
+// The blank and dot imports are ignored.
+func getFileImports(fp string) (map[string]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), fp, nil, parser.ImportsOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	imports := make(map[string]string)
+
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		var name string
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+
+		if name == "_" || name == "." {
+			continue
+		}
+
+		imports[path] = name
+	}
+
+	return imports, nil
+}
+
+// typeResolver resolves the type expressions of the type arguments.
+type typeResolver struct {
+	scopes []*types.Scope
+	pkgs   map[string]*types.Package // by package name.
+}
+
+// newTypeResolver creates a resolver of the type arguments of a directive.
+// The names are resolved inside the package of the directive, then inside the package of the generic type.
+// The package qualifiers are resolved, in order, with the imports of the file of the directive,
+// the package of the generic type and its imports, then the imports of the package of the directive.
+func newTypeResolver(pkg *types.Package, pkgs map[string]*packages.Package, pkgPath string, imports map[string]string) typeResolver {
+	r := typeResolver{
+		pkgs: make(map[string]*types.Package),
+	}
+
+	// The names declared next to the directive come first.
+	directivePkg := pkgs[pkgPath]
+	if directivePkg != nil && directivePkg.Types != nil {
+		r.scopes = append(r.scopes, directivePkg.Types.Scope())
+	}
+
+	r.scopes = append(r.scopes, pkg.Scope(), types.Universe)
+
+	add := func(name string, p *types.Package) {
+		if _, ok := r.pkgs[name]; !ok {
+			r.pkgs[name] = p
+		}
+	}
+
+	for _, path := range slices.Sorted(maps.Keys(imports)) {
+		p, ok := pkgs[path]
+		if !ok || p.Types == nil {
+			continue
+		}
+
+		name := imports[path]
+		if name == "" {
+			name = p.Types.Name()
+		}
+
+		add(name, p.Types)
+	}
+
+	add(pkg.Name(), pkg)
+
+	for _, imp := range pkg.Imports() {
+		add(imp.Name(), imp)
+	}
+
+	if directivePkg != nil && directivePkg.Types != nil {
+		for _, imp := range directivePkg.Types.Imports() {
+			add(imp.Name(), imp)
+		}
+	}
+
+	return r
+}
+
+func (r typeResolver) resolve(expr ast.Expr) (types.Type, error) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		for _, scope := range r.scopes {
+			if obj, ok := scope.Lookup(e.Name).(*types.TypeName); ok {
+				return obj.Type(), nil
+			}
+		}
+
+		return nil, fmt.Errorf("unknown type %q", e.Name)
+
+	case *ast.SelectorExpr:
+		x, ok := e.X.(*ast.Ident)
+		if !ok {
+			return nil, fmt.Errorf("unsupported type argument %q", types.ExprString(expr))
+		}
+
+		pkg, ok := r.pkgs[x.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown package %q", x.Name)
+		}
+
+		obj, ok := pkg.Scope().Lookup(e.Sel.Name).(*types.TypeName)
+		if !ok {
+			return nil, fmt.Errorf("unknown type %q", types.ExprString(expr))
+		}
+
+		return obj.Type(), nil
+
+	case *ast.StarExpr:
+		elem, err := r.resolve(e.X)
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewPointer(elem), nil
+
+	case *ast.ArrayType:
+		elem, err := r.resolve(e.Elt)
+		if err != nil {
+			return nil, err
+		}
+
+		if e.Len == nil {
+			return types.NewSlice(elem), nil
+		}
+
+		lit, ok := e.Len.(*ast.BasicLit)
+		if !ok || lit.Kind != token.INT {
+			return nil, fmt.Errorf("unsupported array length %q", types.ExprString(e.Len))
+		}
+
+		length, err := strconv.ParseInt(lit.Value, 0, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewArray(elem, length), nil
+
+	case *ast.MapType:
+		key, err := r.resolve(e.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := r.resolve(e.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewMap(key, value), nil
+
+	case *ast.IndexExpr:
+		return r.resolveInstance(e.X, []ast.Expr{e.Index})
+
+	case *ast.IndexListExpr:
+		return r.resolveInstance(e.X, e.Indices)
+
+	case *ast.InterfaceType:
+		if e.Methods != nil && len(e.Methods.List) > 0 {
+			return nil, fmt.Errorf("unsupported type argument %q", types.ExprString(expr))
+		}
+
+		return types.NewInterfaceType(nil, nil), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported type argument %q", types.ExprString(expr))
+	}
+}
+
+func (r typeResolver) resolveInstance(base ast.Expr, indices []ast.Expr) (types.Type, error) {
+	generic, err := r.resolve(base)
+	if err != nil {
+		return nil, err
+	}
+
+	var args []types.Type
+
+	for _, index := range indices {
+		arg, err := r.resolve(index)
+		if err != nil {
+			return nil, err
+		}
+
+		args = append(args, arg)
+	}
+
+	return types.Instantiate(nil, generic, args, true)
+}
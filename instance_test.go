@@ -0,0 +1,265 @@
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+func Test_splitTypeArgs(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		target       string
+		expectedName string
+		expectedArgs string
+	}{
+		{desc: "no type arguments", target: "store.Repository", expectedName: "store.Repository"},
+		{desc: "type arguments", target: "Repository[User, int]", expectedName: "Repository", expectedArgs: "User, int"},
+		{desc: "nested type arguments", target: "store.Repository[Page[User]]", expectedName: "store.Repository", expectedArgs: "Page[User]"},
+		{desc: "unclosed", target: "Repository[User", expectedName: "Repository[User"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			name, args := splitTypeArgs(test.target)
+
+			assert.Equal(t, test.expectedName, name)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
+
+func Test_instantiate(t *testing.T) {
+	pkg := typeCheck(t, "a/store", `package store
+
+import "context"
+
+type User struct{}
+
+type Page[T any] struct{ Items []T }
+
+type Repository[E any, K comparable] interface {
+	Get(ctx context.Context, id K) (E, error)
+}
+`)
+
+	pkgs := map[string]*packages.Package{pkg.Path(): {PkgPath: pkg.Path(), Types: pkg}}
+
+	obj, ok := pkg.Scope().Lookup("Repository").(*types.TypeName)
+	require.True(t, ok)
+
+	testCases := []struct {
+		desc         string
+		typeArgs     string
+		expectedType string
+		expectedName string
+	}{
+		{
+			desc:         "named and basic",
+			typeArgs:     "User, int",
+			expectedType: "a/store.Repository[a/store.User, int]",
+			expectedName: "UserIntRepository",
+		},
+		{
+			desc:         "basic",
+			typeArgs:     "string, int",
+			expectedType: "a/store.Repository[string, int]",
+			expectedName: "StringIntRepository",
+		},
+		{
+			desc:         "composite",
+			typeArgs:     "*Page[User], [2]string",
+			expectedType: "a/store.Repository[*a/store.Page[a/store.User], [2]string]",
+			expectedName: "UserPagePtrStringArrayRepository",
+		},
+		{
+			desc:         "slice",
+			typeArgs:     "[]User, string",
+			expectedType: "a/store.Repository[[]a/store.User, string]",
+			expectedName: "UserSliceStringRepository",
+		},
+		{
+			desc:         "qualified",
+			typeArgs:     "map[string]context.Context, any",
+			expectedType: "a/store.Repository[map[string]context.Context, any]",
+			expectedName: "StringContextMapAnyRepository",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			named, err := instantiate(obj, test.typeArgs, pkgs, "a/foo", nil)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedType, named.String())
+			assert.Equal(t, test.expectedName, getInstanceName(named))
+		})
+	}
+}
+
+func Test_instantiate_directivePackage(t *testing.T) {
+	pkg := typeCheck(t, "a/fn", `package fn
+
+type User struct{}
+
+type Mapper[T any] func(T) string
+`)
+
+	directivePkg := typeCheck(t, "a/foo", `package foo
+
+type User struct{}
+`)
+
+	pkgs := map[string]*packages.Package{
+		pkg.Path():          {PkgPath: pkg.Path(), Types: pkg},
+		directivePkg.Path(): {PkgPath: directivePkg.Path(), Types: directivePkg},
+	}
+
+	obj, ok := pkg.Scope().Lookup("Mapper").(*types.TypeName)
+	require.True(t, ok)
+
+	// The names declared next to the directive are resolved first.
+	instance, err := instantiate(obj, "User", pkgs, "a/foo", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "a/fn.Mapper[a/foo.User]", instance.String())
+
+	// The names of the package of the generic type are still resolved.
+	instance, err = instantiate(obj, "User", pkgs, "a/bar", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "a/fn.Mapper[a/fn.User]", instance.String())
+}
+
+func Test_instantiate_imports(t *testing.T) {
+	pkg := typeCheck(t, "a/store", `package store
+
+type Repository[E any] interface {
+	Get() E
+}
+`)
+
+	stdLog := types.NewPackage("log", "log")
+	stdLog.Scope().Insert(types.NewNamed(types.NewTypeName(0, stdLog, "Logger", nil), types.Typ[types.Int], nil).Obj())
+
+	xLog := types.NewPackage("a/x/log", "log")
+	xLog.Scope().Insert(types.NewNamed(types.NewTypeName(0, xLog, "Logger", nil), types.Typ[types.String], nil).Obj())
+
+	pkgs := map[string]*packages.Package{
+		pkg.Path():    {PkgPath: pkg.Path(), Types: pkg},
+		stdLog.Path(): {PkgPath: stdLog.Path(), Types: stdLog},
+		xLog.Path():   {PkgPath: xLog.Path(), Types: xLog},
+	}
+
+	obj, ok := pkg.Scope().Lookup("Repository").(*types.TypeName)
+	require.True(t, ok)
+
+	// The qualifiers are resolved with the imports of the file of the directive.
+	instance, err := instantiate(obj, "log.Logger", pkgs, "a/foo", map[string]string{"a/x/log": ""})
+	require.NoError(t, err)
+	assert.Equal(t, "a/store.Repository[a/x/log.Logger]", instance.String())
+
+	instance, err = instantiate(obj, "xlog.Logger", pkgs, "a/foo", map[string]string{"a/x/log": "xlog", "log": ""})
+	require.NoError(t, err)
+	assert.Equal(t, "a/store.Repository[a/x/log.Logger]", instance.String())
+
+	// The loaded packages that are not imported are not used.
+	_, err = instantiate(obj, "log.Logger", pkgs, "a/foo", nil)
+	require.ErrorContains(t, err, `unknown package "log"`)
+}
+
+func Test_instantiate_alias(t *testing.T) {
+	pkg := typeCheck(t, "a/store", `package store
+
+type User struct{}
+
+type Repository[E any, K comparable] interface {
+	Get(id K) (E, error)
+}
+
+type Store[E any] = Repository[E, string]
+`)
+
+	obj, ok := pkg.Scope().Lookup("Store").(*types.TypeName)
+	require.True(t, ok)
+
+	instance, err := instantiate(obj, "User", nil, "a/foo", nil)
+	require.NoError(t, err)
+
+	assert.IsType(t, &types.Alias{}, instance)
+	assert.Equal(t, "a/store.Repository[a/store.User, string]", types.Unalias(instance).String())
+	assert.Equal(t, "UserStore", getInstanceName(instance))
+}
+
+func Test_instantiate_error(t *testing.T) {
+	pkg := typeCheck(t, "a/store", `package store
+
+type Repository[E any, K comparable] interface {
+	Get(id K) E
+}
+`)
+
+	obj, ok := pkg.Scope().Lookup("Repository").(*types.TypeName)
+	require.True(t, ok)
+
+	testCases := []struct {
+		desc     string
+		typeArgs string
+		expected string
+	}{
+		{
+			desc:     "unknown type",
+			typeArgs: "User, int",
+			expected: `unknown type "User"`,
+		},
+		{
+			desc:     "unknown package",
+			typeArgs: "foo.User, int",
+			expected: `unknown package "foo"`,
+		},
+		{
+			desc:     "wrong number of type arguments",
+			typeArgs: "int",
+			expected: "instantiate Repository[int]: ",
+		},
+		{
+			desc:     "unsatisfied constraint",
+			typeArgs: "int, []int",
+			expected: "instantiate Repository[int, []int]: ",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := instantiate(obj, test.typeArgs, nil, "a/foo", nil)
+			require.ErrorContains(t, err, test.expected)
+		})
+	}
+}
+
+func typeCheck(t *testing.T, pkgPath, src string) *types.Package {
+	t.Helper()
+
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, "src.go", src, 0)
+	require.NoError(t, err)
+
+	conf := types.Config{Importer: importer.Default()}
+
+	pkg, err := conf.Check(pkgPath, fset, []*ast.File{file}, nil)
+	require.NoError(t, err)
+
+	return pkg
+}
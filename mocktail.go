@@ -2,367 +2,1286 @@
 package main
 
 import (
-	"bufio"
 	"bytes"
 	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"go/build/constraint"
 	"go/format"
+	"go/parser"
+	"go/token"
 	"go/types"
+	"io"
 	"io/fs"
 	"log"
+	"maps"
 	"os"
 	"path"
 	"path/filepath"
+	"runtime"
+	"slices"
 	"strings"
+	"sync"
 	"text/template"
+	"unicode"
 
+	"github.com/pmezard/go-difflib/difflib"
 	"golang.org/x/tools/go/packages"
 )
 
 const (
-	srcMockFile            = "mock_test.go"
 	outputMockFile         = "mock_gen_test.go"
 	outputExportedMockFile = "mock_gen.go"
 )
 
+const (
+	generateCommand = "generate"
+	cleanCommand    = "clean"
+)
+
 const contextType = "context.Context"
 
-const commentTagPattern = "// mocktail:"
+// funcMethodName is the name of the method of the mocks of function types.
+const funcMethodName = "Execute"
+
+const (
+	commentTagPattern = "// mocktail:"
+	excludeTagPattern = "// mocktail-:"
+)
 
 // PackageDesc represent a package.
 type PackageDesc struct {
-	Pkg        *types.Package
-	Imports    map[string]struct{}
-	Interfaces []InterfaceDesc
+	Pkg          *types.Package
+	Imports      map[string]struct{}
+	Interfaces   []InterfaceDesc
+	TemplateFile string // Custom template file, the default template is used if empty.
+
+	BuildConstraint string // Build constraint of the file (ex: `mocks`), the file is always built if empty.
 }
 
 // InterfaceDesc represent an interface.
 type InterfaceDesc struct {
-	Name       string
-	Methods    []*types.Func
-	TypeParams *types.TypeParamList // Generic type parameters
+	Name            string
+	Methods         []*types.Func
+	TypeParams      *types.TypeParamList // Generic type parameters
+	MockName        string
+	ConstructorName string
+	Exported        bool
+	FuncType        *types.Named   // Set when the mock is a mock of a function type.
+	Pos             token.Position // Position of the directive.
+}
+
+// walkOptions contains the options of the walk.
+type walkOptions struct {
+	Exported bool
+	Strict   bool // The unresolved directives are errors instead of warnings.
+	Config   config
+	Excludes []string // Globs of the excluded files and directories.
+	Package  string   // Output package of the exported mocks, relative to the package directory.
+	BuildTag string   // Build constraint of the exported mocks.
+	Build    buildOptions
+}
+
+// buildOptions contains the options of the build system used to load the packages.
+type buildOptions struct {
+	Tags  []string
+	Flags []string // ex: `-mod=vendor`.
+	Env   []string // additional environment variables (ex: `GOOS=windows`).
+}
+
+// cliFlags contains the command line flags.
+type cliFlags struct {
+	Exported     bool
+	Strict       bool
+	KeepGoing    bool
+	Check        bool
+	NoCache      bool
+	Nested       bool
+	TemplateFile string
+	Package      string
+	BuildTag     string
+	Tags         string
+	BuildFlags   string
+	Workers      int
+	Excludes     stringList
+
+	set map[string]bool // the flags explicitly set.
 }
 
 func main() {
 	ctx := context.Background()
 
-	info, err := getModuleInfo(ctx, os.Getenv("MOCKTAIL_TEST_PATH"))
+	modules, err := getModules(ctx, os.Getenv("MOCKTAIL_TEST_PATH"))
 	if err != nil {
 		log.Fatal("get module path", err)
 	}
 
-	var exported bool
-	var templateFile string
-
-	flag.BoolVar(&exported, "e", false, "generate exported mocks")
-	flag.StringVar(&templateFile, "template", "", "path to custom template file (uses embedded template if not specified)")
+	var flags cliFlags
+
+	flag.BoolVar(&flags.Exported, "e", false, "generate exported mocks")
+	flag.BoolVar(&flags.Strict, "strict", false, "fail on unresolved directives")
+	flag.BoolVar(&flags.KeepGoing, "keep-going", false, "generate the mocks that can be generated despite the errors")
+	flag.BoolVar(&flags.Check, "check", false, "check that the mocks are up to date, without writing them")
+	flag.BoolVar(&flags.NoCache, "no-cache", false, "render all the mocks, even if their inputs are unchanged")
+	flag.StringVar(&flags.TemplateFile, "template", "", "path to custom template file (uses embedded template if not specified)")
+	flag.IntVar(&flags.Workers, "j", runtime.GOMAXPROCS(0), "number of files generated concurrently")
+	flag.BoolVar(&flags.Nested, "nested", false, "generate the mocks of the nested modules")
+	flag.StringVar(&flags.BuildTag, "build-tag", "", "build constraint of the exported mocks (ex: mocks)")
+	flag.StringVar(&flags.Package, "package", "", "output package of the exported mocks, relative to the package directory (ex: ../{{ .PackageName }}mock)")
+	flag.Var(&flags.Excludes, "exclude", "glob of the files and directories to exclude (can be repeated)")
+	flag.StringVar(&flags.Tags, "tags", "", "comma-separated list of build tags used to load the packages")
+	flag.StringVar(&flags.BuildFlags, "buildflags", "", "space-separated list of build flags used to load the packages (ex: -mod=vendor)")
 	flag.Parse()
 
-	root := info.Dir
+	// The subcommand `clean` accepts the flags after its name.
+	command := generateCommand
+	if flag.NArg() > 0 {
+		command = flag.Arg(0)
 
-	err = os.Chdir(root)
-	if err != nil {
-		log.Fatalf("Chdir: %v", err)
+		err = flag.CommandLine.Parse(flag.Args()[1:])
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if command != generateCommand && command != cleanCommand {
+		log.Fatalf("unknown command %q", command)
+	}
+
+	if flags.Nested {
+		modules, err = addNestedModules(modules, flags.Excludes)
+		if err != nil {
+			log.Fatalf("find nested modules: %v", err)
+		}
+	}
+
+	flags.set = make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) { flags.set[f.Name] = true })
+
+	var failed bool
+
+	// The modules of a workspace are generated one after the other.
+	for _, mod := range modules {
+		if command == cleanCommand {
+			err = clean(mod, flags)
+		} else {
+			err = run(mod, flags)
+		}
+
+		if err == nil {
+			continue
+		}
+
+		if !flags.KeepGoing {
+			log.Fatal(err)
+		}
+
+		log.Print(err)
+
+		failed = true
 	}
 
-	model, err := walk(root, info.Path)
+	// With `-keep-going`, the errors are reported but the generation fails.
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// buildFlags returns the flags of the build system (`go list`).
+func (o buildOptions) buildFlags() []string {
+	flags := slices.Clone(o.Flags)
+
+	if len(o.Tags) > 0 {
+		flags = append(flags, "-tags="+strings.Join(o.Tags, ","))
+	}
+
+	return flags
+}
+
+// splitTags splits a list of build tags separated by commas (or spaces, like `go build -tags`).
+func splitTags(tags string) []string {
+	return strings.FieldsFunc(tags, func(r rune) bool { return r == ',' || unicode.IsSpace(r) })
+}
+
+// stringList is a repeatable flag.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// run generates the mocks of a module.
+func run(mod modInfo, flags cliFlags) error {
+	root := mod.Dir
+
+	cfg, err := loadConfig(root)
 	if err != nil {
-		log.Fatalf("walk: %v", err)
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	// The flags take precedence over the configuration file.
+	exported := flags.Exported
+	if !flags.set["e"] && cfg.Exported != nil {
+		exported = *cfg.Exported
 	}
 
-	if len(model) == 0 {
-		return
+	strict := flags.Strict
+	if !flags.set["strict"] && cfg.Strict != nil {
+		strict = *cfg.Strict
 	}
 
-	tmpl, err := getTemplate(templateFile)
+	templateFile := flags.TemplateFile
+	if templateFile == "" {
+		templateFile = cfg.Template
+	}
+
+	outputPackage := flags.Package
+	if outputPackage == "" {
+		outputPackage = cfg.Package
+	}
+
+	buildTag := flags.BuildTag
+	if buildTag == "" {
+		buildTag = cfg.BuildTag
+	}
+
+	err = checkBuildConstraint(buildTag)
 	if err != nil {
-		log.Fatalf("parse template: %v", err)
+		return err
 	}
 
-	err = generate(model, exported, tmpl)
+	build := buildOptions{
+		Tags:  cfg.Tags,
+		Flags: cfg.BuildFlags,
+		Env:   cfg.Env,
+	}
+
+	if flags.set["tags"] {
+		build.Tags = splitTags(flags.Tags)
+	}
+
+	if flags.set["buildflags"] {
+		build.Flags = strings.Fields(flags.BuildFlags)
+	}
+
+	err = os.Chdir(root)
 	if err != nil {
-		log.Fatalf("generate: %v", err)
+		return fmt.Errorf("chdir: %w", err)
 	}
-}
 
-//nolint:gocognit,gocyclo // The complexity is expected.
-func walk(root, moduleName string) (map[string]PackageDesc, error) {
-	model := make(map[string]PackageDesc)
+	opts := walkOptions{
+		Exported: exported,
+		Strict:   strict,
+		Config:   cfg,
+		Excludes: append(slices.Clone(flags.Excludes), cfg.Exclude...),
+		Package:  outputPackage,
+		BuildTag: buildTag,
+		Build:    build,
+	}
+
+	model, unresolved, walkErr := walk(root, mod.Path, opts)
+	if walkErr != nil && (!flags.KeepGoing || model == nil) {
+		return fmt.Errorf("walk: %w", walkErr)
+	}
+
+	var cache *fileCache
 
-	err := filepath.WalkDir(root, func(fp string, d fs.DirEntry, err error) error {
+	if !flags.NoCache {
+		// The cache is an optimization: the mocks are rendered without it.
+		cache, err = loadCache(root)
 		if err != nil {
-			return err
+			log.Printf("cache disabled: %v", err)
 		}
+	}
 
-		if d.IsDir() {
-			if d.Name() == "testdata" || d.Name() == "vendor" {
-				return filepath.SkipDir
-			}
+	// The cache also records the generated files: the orphaned outputs of the `package` option are detected without `-e`.
+	recorded := cache.generatedFiles(exported)
+
+	if cache != nil {
+		cache.Exported = exported
+	}
 
-			return nil
+	var checkErr error
+
+	if len(model) > 0 {
+		tmpl, err := getTemplate(templateFile)
+		if err != nil {
+			return fmt.Errorf("parse template: %w", err)
 		}
 
-		if d.Name() != srcMockFile {
-			return nil
+		genOpts := generateOptions{
+			Workers:      flags.Workers,
+			Check:        flags.Check,
+			Diff:         os.Stdout,
+			TemplateFile: templateFile,
+			Cache:        cache,
 		}
 
-		file, err := os.Open(fp)
+		err = generate(model, tmpl, genOpts)
+		if err != nil && !flags.Check {
+			return fmt.Errorf("generate: %w", err)
+		}
+
+		checkErr = err
+	}
+
+	if walkErr != nil {
+		return fmt.Errorf("walk: %w", errors.Join(walkErr, checkErr))
+	}
+
+	// The model is complete: the generated files without mocks are orphaned.
+	err = removeOrphans(root, model, unresolved, recorded, exported, opts.Excludes, flags.Check)
+	if flags.Check {
+		err = errors.Join(checkErr, err)
 		if err != nil {
-			return err
+			return fmt.Errorf("check: %w", err)
 		}
 
-		packageDesc := PackageDesc{Imports: map[string]struct{}{}}
+		return nil
+	}
 
-		scanner := bufio.NewScanner(file)
-		for scanner.Scan() {
-			line := scanner.Text()
-			if line == "" {
-				continue
-			}
+	if err != nil {
+		return fmt.Errorf("remove orphaned files: %w", err)
+	}
+
+	return nil
+}
+
+// walk resolves the directives of a module and returns the mocks grouped by output file.
+// The output directories of the unresolved directives are returned with the model (non-strict mode):
+// their generated files cannot be considered as orphaned.
+//
+//nolint:gocognit,gocyclo // The complexity is expected.
+func walk(root, moduleName string, opts walkOptions) (map[string]PackageDesc, []string, error) {
+	// The invalid directives are collected to report all of them at once.
+	var problems []error
+
+	directives, err := collectDirectives(root, opts.Exported, newIgnoreMatcher(root, opts.Excludes))
+	if directives == nil {
+		return nil, nil, err
+	}
+
+	problems = append(problems, err)
+
+	cfgDirectives, err := opts.Config.directives(root)
+	problems = append(problems, err)
+
+	directives, err = mergeDirectives(root, moduleName, directives, cfgDirectives)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dirs := slices.Sorted(maps.Keys(directives))
+
+	// Collects the packages of the directives, and the import paths to load.
+	dirPkgs := make(map[string]*types.Package)
+
+	// Imports of the files of the directives with type arguments, by file.
+	fileImports := make(map[string]map[string]string)
+
+	var importPaths []string
+
+	for _, dir := range dirs {
+		pkg, err := getDirectivePackage(root, moduleName, dir, directives[dir])
+		if err != nil {
+			return nil, nil, err
+		}
 
-			i := strings.Index(line, commentTagPattern)
-			if i <= -1 {
+		dirPkgs[dir] = pkg
+
+		for _, d := range directives[dir] {
+			if d.Exclude {
 				continue
 			}
 
-			interfaceName := line[i+len(commentTagPattern):]
+			paths, _ := getImportPaths(moduleName, pkg.Path(), d.Target)
+			importPaths = append(importPaths, paths...)
 
-			var importPath string
-			if index := strings.LastIndex(interfaceName, "."); index > 0 {
-				importPath = path.Join(moduleName, interfaceName[:index])
+			// The type arguments can be declared inside the package of the directive, or qualified by the imports of its file.
+			if _, typeArgs := splitTypeArgs(d.Target); typeArgs != "" {
+				importPaths = append(importPaths, pkg.Path())
 
-				interfaceName = interfaceName[index+1:]
-			} else {
-				filePkgName, err := filepath.Rel(root, filepath.Dir(fp))
+				imports, err := getDirectiveImports(d, fileImports)
 				if err != nil {
-					return err
+					problems = append(problems, fmt.Errorf("%s: %w", d.Pos, err))
 				}
 
-				importPath = path.Join(moduleName, filePkgName)
+				importPaths = append(importPaths, slices.Collect(maps.Keys(imports))...)
+			}
+		}
+	}
+
+	if len(importPaths) == 0 {
+		return nil, nil, errors.Join(problems...)
+	}
+
+	// All the packages are loaded at once: `go list` is only called once.
+	pkgs, err := loadPackages(root, importPaths, opts.Build)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// output package path + mock name, constructor name, or prefix of the Call types -> interface.
+	mockNames := make(map[string]string)
+
+	// Output directories of the unresolved directives.
+	var unresolved []string
+
+	// The mocks are grouped by file once the build constraints of all their directives are known.
+	var mocks []*mockEntry
+
+	entries := make(map[string]*mockEntry)
+
+	for _, dir := range dirs {
+		pkg := dirPkgs[dir]
+
+		var includes, excludes []directive
+
+		for _, d := range directives[dir] {
+			if d.Exclude {
+				excludes = append(excludes, d)
+			} else {
+				includes = append(includes, d)
 			}
+		}
 
-			pkgs, err := packages.Load(
-				&packages.Config{
-					Mode: packages.NeedTypes,
-					Dir:  root,
-				},
-				importPath,
-			)
+		for _, d := range includes {
+			importPaths, name := getImportPaths(moduleName, pkg.Path(), d.Target)
+			_, typeArgs := splitTypeArgs(d.Target)
+
+			exported := d.Options.isExported(opts.Exported)
+
+			outPkg, outDir, err := getOutputPackage(root, dir, pkg, d.Options.getPackage(exported, opts.Package))
 			if err != nil {
-				return fmt.Errorf("load package %q: %w", importPath, err)
+				problems = append(problems, fmt.Errorf("%s: %w", d.Pos, err))
+				continue
+			}
+
+			var lookups []*types.TypeName
+
+			if isNamePattern(name) {
+				lookups = lookupInterfaces(pkgs, outPkg.Path(), importPaths, newNameMatcher(name))
+			} else if lookup := lookupType(pkgs, name, importPaths); lookup != nil {
+				lookups = append(lookups, lookup)
 			}
 
-			// Only one package specified by the import path has been loaded.
-			lookup := pkgs[0].Types.Scope().Lookup(interfaceName)
-			if lookup == nil {
-				log.Printf("Unable to find: %s", interfaceName)
+			// The mocks of a package with errors would be incomplete.
+			if err := loadErrors(d, pkgs, lookups, importPaths); err != nil {
+				problems = append(problems, err)
 				continue
 			}
 
-			if packageDesc.Pkg == nil {
-				packageDesc.Pkg = lookup.Pkg()
+			if len(lookups) == 0 {
+				err := unresolvedError(d, pkgs, name, importPaths)
+				if opts.Strict {
+					problems = append(problems, err)
+				} else {
+					log.Print(err)
+
+					unresolved = append(unresolved, outDir)
+				}
+
+				continue
 			}
 
-			interfaceDesc := InterfaceDesc{Name: interfaceName}
+			for _, lookup := range lookups {
+				if isExcluded(lookup, moduleName, pkg.Path(), excludes) {
+					continue
+				}
+
+				typ := lookup.Type()
+				interfaceName := lookup.Name()
+				key := lookup.Pkg().Path() + "." + lookup.Name()
+
+				if typeArgs != "" {
+					instance, err := instantiate(lookup, typeArgs, pkgs, pkg.Path(), fileImports[d.Pos.Filename])
+					if err != nil {
+						problems = append(problems, fmt.Errorf("%s: %w", d.Pos, err))
+						continue
+					}
+
+					typ = instance
+					interfaceName = getInstanceName(instance)
+					key = types.TypeString(instance, nil)
+				}
+
+				interfaceDesc := InterfaceDesc{
+					Name:            interfaceName,
+					MockName:        d.Options.Name,
+					ConstructorName: d.Options.Constructor,
+					Exported:        exported,
+					Pos:             d.Pos,
+				}
+
+				if interfaceDesc.MockName == "" {
+					interfaceDesc.MockName, err = opts.Config.Naming.mockName(interfaceDesc.Name, interfaceDesc.Exported, outPkg.Path() != pkg.Path())
+					if err != nil {
+						problems = append(problems, fmt.Errorf("%s: mock name: %w", d.Pos, err))
+						continue
+					}
+				}
+
+				if interfaceDesc.ConstructorName == "" {
+					interfaceDesc.ConstructorName, err = opts.Config.Naming.constructorName(interfaceDesc.Name, interfaceDesc.Exported)
+					if err != nil {
+						problems = append(problems, fmt.Errorf("%s: constructor name: %w", d.Pos, err))
+						continue
+					}
+				}
+
+				// The same interface can be requested by several directives of the package.
+				mockKey := outPkg.Path() + "." + interfaceDesc.MockName
+				if previous, ok := mockNames[mockKey]; ok {
+					if previous == key {
+						// The mock is built when one of the directives is built.
+						if entry, ok := entries[mockKey]; ok {
+							entry.constraints = append(entry.constraints, d.Constraint)
+						}
+
+						continue
+					}
+
+					problems = append(problems, fmt.Errorf("%s: the mock name %q is already used by %s, use the `name` option", d.Pos, interfaceDesc.MockName, previous))
+
+					continue
+				}
+
+				// The constructor and the Call types are declared in the same package as the mock type.
+				constructorKey := outPkg.Path() + "." + interfaceDesc.ConstructorName
+				if previous, ok := mockNames[constructorKey]; ok {
+					problems = append(problems, fmt.Errorf("%s: the constructor name %q is already used by %s, use the `constructor` option", d.Pos, interfaceDesc.ConstructorName, previous))
+
+					continue
+				}
+
+				// Check if this is a generic interface (or a generic alias): an instantiated interface is not generic.
+				switch v := typ.(type) {
+				case *types.Named:
+					if v.TypeArgs().Len() == 0 {
+						interfaceDesc.TypeParams = v.TypeParams()
+					}
+
+				case *types.Alias:
+					if v.TypeArgs().Len() == 0 {
+						interfaceDesc.TypeParams = v.TypeParams()
+					}
+				}
+
+				// The function type of an alias is the target of the alias.
+				namedType, isNamed := types.Unalias(typ).(*types.Named)
+
+				var methods []*types.Func
+
+				switch underlying := typ.Underlying().(type) {
+				case *types.Interface:
+					methods = slices.Collect(underlying.Methods())
+
+				case *types.Signature:
+					if !isNamed {
+						problems = append(problems, fmt.Errorf("%s: type %q is not a named function type", d.Pos, typ))
+						continue
+					}
+
+					// A function type is mocked as an interface with a single method.
+					interfaceDesc.FuncType = namedType
+					methods = []*types.Func{types.NewFunc(lookup.Pos(), lookup.Pkg(), funcMethodName, underlying)}
+
+				default:
+					problems = append(problems, fmt.Errorf("%s: type %q is not an interface or a function type", d.Pos, typ))
+					continue
+				}
+
+				// The patterns only match the mockable interfaces, the other directives are reported.
+				if len(methods) == 0 {
+					problems = append(problems, fmt.Errorf("%s: interface %q has no methods", d.Pos, typ))
+					continue
+				}
+
+				// The Call types of the methods (ex: `fooDoCall`) can be the Call types of the methods of another mock (ex: `fooDo` of `fooMock` and `Do` of `fooDoMock`).
+				callPrefix := getCallPrefix(interfaceDesc.MockName)
+
+				callKeys := make([]string, 0, len(methods))
+
+				var conflict error
 
-			// Check if this is a generic interface
-			if namedType, ok := lookup.Type().(*types.Named); ok {
-				interfaceDesc.TypeParams = namedType.TypeParams()
+				for _, method := range methods {
+					callName := callPrefix + method.Name() + "Call"
+
+					callKey := outPkg.Path() + "." + callName
+					if previous, ok := mockNames[callKey]; ok {
+						conflict = fmt.Errorf("%s: the Call type name %q is already used by %s, use the `name` option", d.Pos, callName, previous)
+						break
+					}
+
+					callKeys = append(callKeys, callKey)
+				}
+
+				if conflict != nil {
+					problems = append(problems, conflict)
+					continue
+				}
+
+				mockNames[mockKey] = key
+				mockNames[constructorKey] = key
+
+				for _, callKey := range callKeys {
+					mockNames[callKey] = key
+				}
+
+				// The mocks can be generated in another package.
+				err = checkExported(interfaceDesc, methods, outPkg.Path())
+				if err != nil {
+					problems = append(problems, fmt.Errorf("%s: %s cannot be mocked in the package %s: %w", d.Pos, interfaceName, outPkg.Path(), err))
+					continue
+				}
+
+				templateFile := d.Options.Template
+				if templateFile != "" && !filepath.IsAbs(templateFile) {
+					templateFile = filepath.Join(filepath.Dir(d.Pos.Filename), templateFile)
+				}
+
+				interfaceDesc.Methods = methods
+
+				entry := &mockEntry{
+					desc:         interfaceDesc,
+					pkg:          outPkg,
+					dir:          outDir,
+					file:         d.Options.File,
+					templateFile: templateFile,
+					constraints:  []constraint.Expr{d.Constraint},
+				}
+
+				mocks = append(mocks, entry)
+				entries[mockKey] = entry
 			}
+		}
+	}
+
+	// The build tag is validated by the caller.
+	var buildTag constraint.Expr
+	if opts.BuildTag != "" {
+		buildTag, _ = constraint.Parse("//go:build " + opts.BuildTag)
+	}
+
+	model := make(map[string]PackageDesc)
+
+	for _, entry := range mocks {
+		interfaceDesc := entry.desc
+
+		directivesExpr := orConstraints(entry.constraints...)
 
-			interfaceType, ok := lookup.Type().Underlying().(*types.Interface)
-			if !ok {
-				return fmt.Errorf("type %q in %q is not an interface", lookup.Type(), fp)
+		out := filepath.Join(entry.dir, getOutputFileName(entry.file, interfaceDesc.Exported, directivesExpr))
+
+		buildExpr := directivesExpr
+
+		// Only the exported mocks are gated by the build tag.
+		if interfaceDesc.Exported {
+			buildExpr = andConstraints(buildTag, directivesExpr)
+		}
+
+		buildConstraint := constraintString(buildExpr)
+
+		packageDesc, ok := model[out]
+		if !ok {
+			packageDesc = PackageDesc{
+				Pkg:             entry.pkg,
+				Imports:         map[string]struct{}{},
+				TemplateFile:    entry.templateFile,
+				BuildConstraint: buildConstraint,
 			}
+		} else if packageDesc.TemplateFile != entry.templateFile {
+			problems = append(problems, fmt.Errorf("%s: the template %q conflicts with the template %q of the file %s, use the `file` option",
+				interfaceDesc.Pos, entry.templateFile, packageDesc.TemplateFile, out))
 
-			for method := range interfaceType.Methods() {
-				interfaceDesc.Methods = append(interfaceDesc.Methods, method)
+			continue
+		} else if packageDesc.BuildConstraint != buildConstraint {
+			problems = append(problems, fmt.Errorf("%s: the mocks of the file %s have different build constraints (%q, %q), use the `file` option",
+				interfaceDesc.Pos, out, packageDesc.BuildConstraint, buildConstraint))
 
-				for _, imp := range getMethodImports(method, packageDesc.Pkg.Path()) {
-					packageDesc.Imports[imp] = struct{}{}
-				}
+			continue
+		}
+
+		// The imports are collected like the names of the import registry.
+		pkgs := make(map[string]*types.Package)
+		collectInterfacePackages(interfaceDesc, pkgs)
+
+		for pkgPath := range pkgs {
+			if pkgPath != packageDesc.Pkg.Path() {
+				packageDesc.Imports[pkgPath] = struct{}{}
 			}
+		}
+
+		packageDesc.Interfaces = append(packageDesc.Interfaces, interfaceDesc)
+
+		model[out] = packageDesc
+	}
+
+	// The model contains the mocks that can be generated despite the problems.
+	return model, unresolved, errors.Join(problems...)
+}
+
+// mockEntry is a mock to generate, with the build constraints of the files of its directives.
+type mockEntry struct {
+	desc         InterfaceDesc  // with the position of the first directive.
+	pkg          *types.Package // output package.
+	dir          string         // output directory.
+	file         string         // output file name (option `file`).
+	templateFile string
+	constraints  []constraint.Expr
+}
+
+// getOutputFileName returns the name of the file where a mock is generated.
+// The mocks gated by a build constraint are generated in a dedicated file.
+func getOutputFileName(file string, exported bool, expr constraint.Expr) string {
+	switch {
+	case file != "":
+		return file
+	case expr != nil:
+		return constraintFileName(expr, exported)
+	case exported:
+		return outputExportedMockFile
+	default:
+		return outputMockFile
+	}
+}
+
+// getDirectivePackage returns the package where the mocks of the directives of a directory are generated.
+func getDirectivePackage(root, moduleName, dir string, directives []directive) (*types.Package, error) {
+	rel, err := filepath.Rel(root, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fp, err := getPackageFile(dir, directives)
+	if err != nil {
+		return nil, err
+	}
 
-			packageDesc.Interfaces = append(packageDesc.Interfaces, interfaceDesc)
+	file, err := parser.ParseFile(token.NewFileSet(), fp, nil, parser.PackageClauseOnly)
+	if err != nil {
+		return nil, fmt.Errorf("parse package clause: %w", err)
+	}
+
+	// The mocks of an external test package (`xxx_test`) are generated inside the package itself.
+	name := strings.TrimSuffix(file.Name.Name, "_test")
+
+	return types.NewPackage(path.Join(moduleName, filepath.ToSlash(rel)), name), nil
+}
+
+// getPackageFile returns a Go file of the package:
+// a file containing directives, or the first Go file of the directory (directives from the configuration file).
+func getPackageFile(dir string, directives []directive) (string, error) {
+	for _, d := range directives {
+		if strings.HasSuffix(d.Pos.Filename, ".go") {
+			return d.Pos.Filename, nil
 		}
+	}
+
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", err
+	}
 
-		if len(packageDesc.Interfaces) > 0 {
-			model[fp] = packageDesc
+	if len(matches) == 0 {
+		return "", fmt.Errorf("%s: no Go files in %s", directives[0].Pos, dir)
+	}
+
+	return matches[0], nil
+}
+
+// getImportPaths returns the candidate import paths of a directive target and the name part of the target.
+// A qualified target is resolved as a full import path (stdlib, dependencies),
+// then as a path relative to the module.
+func getImportPaths(moduleName, pkgPath, target string) ([]string, string) {
+	target, _ = splitTypeArgs(target)
+
+	prefix, name := splitTarget(target)
+	if prefix == "" {
+		return []string{pkgPath}, name
+	}
+
+	return []string{prefix, path.Join(moduleName, prefix)}, name
+}
+
+// splitTarget splits a directive target into the package part and the name part.
+//
+//	Foo, store.Foo, net/http.Foo, *, store.*, /.*Repo$/, store./.*Repo$/
+func splitTarget(target string) (string, string) {
+	// The regular expressions can contain dots.
+	if len(target) > 1 && strings.HasSuffix(target, "/") {
+		if strings.HasPrefix(target, "/") {
+			return "", target
 		}
 
-		return nil
-	})
+		if index := strings.Index(target, "./"); index > 0 {
+			return target[:index], target[index+1:]
+		}
+	}
+
+	index := strings.LastIndex(target, ".")
+	if index <= 0 {
+		return "", target
+	}
+
+	return target[:index], target[index+1:]
+}
+
+// loadPackages loads the packages and returns them by import path.
+func loadPackages(root string, importPaths []string, build buildOptions) (map[string]*packages.Package, error) {
+	importPaths = slices.Compact(slices.Sorted(slices.Values(importPaths)))
+
+	pkgs, err := packages.Load(
+		&packages.Config{
+			Mode:       packages.NeedName | packages.NeedTypes,
+			Dir:        root,
+			BuildFlags: build.buildFlags(),
+			Env:        append(os.Environ(), build.Env...),
+		},
+		importPaths...,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("walk dir: %w", err)
+		return nil, fmt.Errorf("load packages: %w", err)
+	}
+
+	byPath := make(map[string]*packages.Package)
+	for _, pkg := range pkgs {
+		byPath[pkg.PkgPath] = pkg
 	}
 
-	return model, nil
+	return byPath, nil
 }
 
-func getMethodImports(method *types.Func, importPath string) []string {
-	signature := method.Signature()
+// loadErrors returns the errors of the package of the resolved types of a directive.
+// When the directive is not resolved, the type and syntax errors of the candidate packages are returned:
+// the list errors of the candidates are expected (ex: `a/net/http` for `net/http`).
+func loadErrors(d directive, pkgs map[string]*packages.Package, lookups []*types.TypeName, importPaths []string) error {
+	if len(lookups) > 0 {
+		return packageErrors(d, pkgs[lookups[0].Pkg().Path()], true)
+	}
 
-	var imports []string
+	for _, importPath := range importPaths {
+		err := packageErrors(d, pkgs[importPath], false)
+		if err != nil {
+			return err
+		}
+	}
 
-	for _, imp := range getTupleImports(signature.Params(), signature.Results()) {
-		if imp != "" && imp != importPath {
-			imports = append(imports, imp)
+	return nil
+}
+
+// packageErrors returns the errors of a package attributed to a directive.
+// The list errors are ignored when the package has type or syntax errors: they contain the same errors.
+func packageErrors(d directive, pkg *packages.Package, withListErrors bool) error {
+	if pkg == nil {
+		return nil
+	}
+
+	var errs, listErrs []error
+
+	for _, e := range pkg.Errors {
+		err := fmt.Errorf("%s: %s: %w", d.Pos, pkg.PkgPath, e)
+
+		if e.Kind == packages.ListError {
+			listErrs = append(listErrs, err)
+		} else {
+			errs = append(errs, err)
 		}
 	}
 
-	return imports
+	if len(errs) == 0 && withListErrors {
+		errs = listErrs
+	}
+
+	return errors.Join(errs...)
 }
 
-func getTupleImports(tuples ...*types.Tuple) []string {
-	var imports []string
+// lookupType finds a type by name inside the first matching package.
+// The packages declaring an interface or a function type with this name are preferred.
+func lookupType(pkgs map[string]*packages.Package, name string, importPaths []string) *types.TypeName {
+	var found *types.TypeName
+
+	for _, importPath := range importPaths {
+		pkg, ok := pkgs[importPath]
+		if !ok || pkg.Types == nil {
+			continue
+		}
+
+		obj, ok := pkg.Types.Scope().Lookup(name).(*types.TypeName)
+		if !ok {
+			continue
+		}
+
+		switch obj.Type().Underlying().(type) {
+		case *types.Interface, *types.Signature:
+			return obj
+		}
 
-	for _, tuple := range tuples {
-		for v := range tuple.Variables() {
-			imports = append(imports, getTypeImports(v.Type())...)
+		if found == nil {
+			found = obj
 		}
 	}
 
-	return imports
+	return found
 }
 
-func getTypeImports(t types.Type) []string {
-	switch v := t.(type) {
-	case *types.Basic:
-		return []string{""}
+// lookupInterfaces finds the mockable interfaces matching a name pattern inside the first matching package.
+// The interfaces are sorted by name.
+func lookupInterfaces(pkgs map[string]*packages.Package, pkgPath string, importPaths []string, match func(string) bool) []*types.TypeName {
+	for _, importPath := range importPaths {
+		pkg, ok := pkgs[importPath]
+		if !ok || pkg.Types == nil {
+			continue
+		}
 
-	case *types.Slice:
-		return getTypeImports(v.Elem())
+		var found []*types.TypeName
 
-	case *types.Array:
-		return getTypeImports(v.Elem())
+		scope := pkg.Types.Scope()
+
+		// Names are sorted.
+		for _, name := range scope.Names() {
+			obj, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok || !match(name) || !isMockable(obj, pkgPath) {
+				continue
+			}
 
-	case *types.Struct:
-		var imports []string
-		for f := range v.Fields() {
-			imports = append(imports, getTypeImports(f.Type())...)
+			found = append(found, obj)
 		}
 
-		return imports
+		if len(found) > 0 {
+			return found
+		}
+	}
 
-	case *types.Map:
-		imports := getTypeImports(v.Key())
-		imports = append(imports, getTypeImports(v.Elem())...)
+	return nil
+}
 
-		return imports
+// isMockable reports whether a mock of the type can be generated inside the package.
+func isMockable(obj *types.TypeName, pkgPath string) bool {
+	if obj.IsAlias() {
+		return false
+	}
 
-	case *types.Named:
-		if v.Obj().Pkg() == nil {
-			return []string{""}
+	itf, ok := obj.Type().Underlying().(*types.Interface)
+	if !ok || !itf.IsMethodSet() || itf.NumMethods() == 0 {
+		return false
+	}
+
+	if obj.Pkg().Path() == pkgPath {
+		return true
+	}
+
+	if !obj.Exported() {
+		return false
+	}
+
+	for method := range itf.Methods() {
+		if !method.Exported() {
+			return false
 		}
+	}
 
-		return []string{v.Obj().Pkg().Path()}
+	return true
+}
 
-	case *types.Pointer:
-		return getTypeImports(v.Elem())
+// getDirectiveImports returns the imports of the file of a directive, cached by file.
+// The directives of the configuration file have no imports.
+func getDirectiveImports(d directive, cache map[string]map[string]string) (map[string]string, error) {
+	if !strings.HasSuffix(d.Pos.Filename, ".go") {
+		return nil, nil
+	}
 
-	case *types.Interface:
-		return []string{""}
+	if imports, ok := cache[d.Pos.Filename]; ok {
+		return imports, nil
+	}
 
-	case *types.Signature:
-		return getTupleImports(v.Params(), v.Results())
+	imports, err := getFileImports(d.Pos.Filename)
+	if err != nil {
+		return nil, fmt.Errorf("imports: %w", err)
+	}
 
-	case *types.Chan:
-		return []string{""}
+	cache[d.Pos.Filename] = imports
 
-	case *types.TypeParam:
-		return []string{""}
+	return imports, nil
+}
 
-	default:
-		panic(fmt.Sprintf("OOPS %[1]T %[1]s", t))
+// isExcluded reports whether the type matches one of the exclusion directives.
+func isExcluded(obj *types.TypeName, moduleName, pkgPath string, excludes []directive) bool {
+	for _, d := range excludes {
+		importPaths, name := getImportPaths(moduleName, pkgPath, d.Target)
+
+		if slices.Contains(importPaths, obj.Pkg().Path()) && newNameMatcher(name)(obj.Name()) {
+			return true
+		}
 	}
+
+	return false
 }
 
-func generate(model map[string]PackageDesc, exported bool, tmpl *template.Template) error {
-	for fp, pkgDesc := range model {
-		buffer := bytes.NewBufferString("")
+// generateOptions contains the options of the generation.
+type generateOptions struct {
+	Workers      int
+	Check        bool       // The mocks are compared with the existing files instead of being written.
+	Diff         io.Writer  // Output of the diffs of the files that are not up to date (check mode).
+	TemplateFile string     // Custom template file of the module (flag or configuration).
+	Cache        *fileCache // The rendering of the unchanged files is skipped, nil to render all the files.
+}
 
-		// Create a Syrup instance with the first method to parse the template once
-		if len(pkgDesc.Interfaces) > 0 && len(pkgDesc.Interfaces[0].Methods) > 0 {
-			firstMethod := pkgDesc.Interfaces[0].Methods[0]
-			templateSyrup := &Syrup{
-				PkgPath:       pkgDesc.Pkg.Path(),
-				InterfaceName: pkgDesc.Interfaces[0].Name,
-				Method:        firstMethod,
-				Signature:     firstMethod.Signature(),
-				TypeParams:    pkgDesc.Interfaces[0].TypeParams,
-				Template:      tmpl,
-			}
+func generate(model map[string]PackageDesc, tmpl *template.Template, opts generateOptions) error {
+	outs := slices.Sorted(maps.Keys(model))
 
-			err := templateSyrup.WriteImports(buffer, pkgDesc)
-			if err != nil {
-				return err
-			}
+	results := make([]generateResult, len(outs))
+
+	sem := make(chan struct{}, max(opts.Workers, 1))
+
+	var wg sync.WaitGroup
+
+	for i, out := range outs {
+		wg.Add(1)
+
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			results[i] = generateFile(out, model[out], tmpl, opts)
+		}()
+	}
+
+	wg.Wait()
+
+	// The logs and the errors are reported in the order of the files.
+	var errs []error
+
+	for i, out := range outs {
+		result := results[i]
+
+		if result.diff != "" {
+			_, _ = io.WriteString(opts.Diff, result.diff)
+
+			errs = append(errs, fmt.Errorf("%s: not up to date (directives: %s)", out, getDirectivePositions(model[out])))
+
+			continue
 		}
 
-		for _, interfaceDesc := range pkgDesc.Interfaces {
-			// Write mock base using the template Syrup (or create one if we don't have one)
-			// Create a Syrup for this interface
-			firstMethod := interfaceDesc.Methods[0]
-			baseSyrup := &Syrup{
-				PkgPath:       pkgDesc.Pkg.Path(),
-				InterfaceName: pkgDesc.Interfaces[0].Name,
-				Method:        firstMethod,
-				Signature:     firstMethod.Signature(),
-				TypeParams:    pkgDesc.Interfaces[0].TypeParams,
-				Template:      tmpl,
+		if result.err == nil {
+			if !opts.Check {
+				log.Println(out)
 			}
 
-			err := baseSyrup.WriteMockBase(buffer, interfaceDesc, exported)
-			if err != nil {
-				return err
+			continue
+		}
+
+		if result.source != nil {
+			log.Println(string(result.source))
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %w", out, result.err))
+	}
+
+	if opts.Cache != nil && !opts.Check {
+		for i, out := range outs {
+			if results[i].err == nil && results[i].entry.Fingerprint != "" {
+				opts.Cache.Files[out] = results[i].entry
 			}
+		}
 
-			_, _ = buffer.WriteString("\n")
+		err := opts.Cache.save(outs)
+		if err != nil {
+			log.Printf("save cache: %v", err)
+		}
+	}
 
-			for _, method := range interfaceDesc.Methods {
-				syrup := &Syrup{
-					PkgPath:       pkgDesc.Pkg.Path(),
-					InterfaceName: interfaceDesc.Name,
-					Method:        method,
-					Signature:     method.Signature(),
-					TypeParams:    interfaceDesc.TypeParams,
-					Template:      tmpl,
-				}
+	return errors.Join(errs...)
+}
 
-				err = syrup.MockMethod(buffer)
-				if err != nil {
-					return err
-				}
+// generateResult is the result of the generation of a file.
+type generateResult struct {
+	err    error
+	source []byte // unformatted source, only set when the formatting fails.
+	diff   string // diff with the existing file, only set in check mode.
+	entry  cacheEntry
+}
 
-				err = syrup.Call(buffer, interfaceDesc.Methods)
-				if err != nil {
-					return err
-				}
-			}
+func generateFile(out string, pkgDesc PackageDesc, tmpl *template.Template, opts generateOptions) generateResult {
+	var fingerprint string
+
+	if opts.Cache != nil {
+		// The file is rendered when the fingerprint cannot be computed.
+		fingerprint, _ = opts.Cache.fingerprint(pkgDesc, opts.TemplateFile)
+		if fingerprint != "" && opts.Cache.isUpToDate(out, fingerprint) {
+			return generateResult{entry: opts.Cache.Files[out]}
 		}
+	}
+
+	buffer, err := render(pkgDesc, tmpl)
+	if err != nil {
+		return generateResult{err: err}
+	}
+
+	// gofmt
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return generateResult{err: fmt.Errorf("source: %w", err), source: buffer.Bytes()}
+	}
 
-		// gofmt
-		source, err := format.Source(buffer.Bytes())
+	// The files written by hand (ex: `file=mock_test.go`) are never overwritten, and are not reported as stale in check mode.
+	current, readErr := os.ReadFile(out)
+	if readErr == nil && !hasGeneratedHeader(current) {
+		return generateResult{err: errors.New("the existing file is not generated by mocktail, it is not overwritten")}
+	}
+
+	if opts.Check {
+		diff, err := diffFile(out, source)
 		if err != nil {
-			log.Println(buffer.String())
-			return fmt.Errorf("source: %w", err)
+			return generateResult{err: fmt.Errorf("diff: %w", err)}
 		}
 
-		fileName := outputMockFile
-		if exported {
-			fileName = outputExportedMockFile
+		return generateResult{diff: diff}
+	}
+
+	entry := cacheEntry{Fingerprint: fingerprint, Hash: hashBytes(source)}
+
+	// The unchanged files are not written: their modification times are preserved.
+	if readErr == nil && bytes.Equal(current, source) {
+		return generateResult{entry: entry}
+	}
+
+	// The output package can be a new package.
+	err = os.MkdirAll(filepath.Dir(out), 0o750)
+	if err != nil {
+		return generateResult{err: fmt.Errorf("create directory: %w", err)}
+	}
+
+	err = os.WriteFile(out, source, 0o640)
+	if err != nil {
+		return generateResult{err: fmt.Errorf("write file: %w", err)}
+	}
+
+	return generateResult{entry: entry}
+}
+
+// diffFile returns the unified diff between a file and its expected content, an empty string if they are the same.
+// A missing file is compared as an empty file.
+func diffFile(fp string, expected []byte) (string, error) {
+	current, err := os.ReadFile(fp)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+
+	if bytes.Equal(current, expected) {
+		return "", nil
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(current)),
+		B:        difflib.SplitLines(string(expected)),
+		FromFile: fp,
+		ToFile:   fp + " (generated)",
+		Context:  3,
+	})
+}
+
+// getDirectivePositions returns the positions of the directives of the mocks of a file.
+func getDirectivePositions(pkgDesc PackageDesc) string {
+	var positions []string
+
+	for _, interfaceDesc := range pkgDesc.Interfaces {
+		positions = append(positions, interfaceDesc.Pos.String())
+	}
+
+	return strings.Join(positions, ", ")
+}
+
+func render(pkgDesc PackageDesc, tmpl *template.Template) (*bytes.Buffer, error) {
+	pkgTmpl := tmpl
+
+	if pkgDesc.TemplateFile != "" {
+		var err error
+
+		pkgTmpl, err = getTemplate(pkgDesc.TemplateFile)
+		if err != nil {
+			return nil, fmt.Errorf("parse template: %w", err)
+		}
+	}
+
+	buffer := bytes.NewBufferString("")
+
+	imports := newImportRegistry(pkgDesc)
+
+	// Create a Syrup instance with the first method to parse the template once
+	if len(pkgDesc.Interfaces) > 0 && len(pkgDesc.Interfaces[0].Methods) > 0 {
+		firstMethod := pkgDesc.Interfaces[0].Methods[0]
+		templateSyrup := &Syrup{
+			PkgPath:       pkgDesc.Pkg.Path(),
+			InterfaceName: pkgDesc.Interfaces[0].Name,
+			MockName:      pkgDesc.Interfaces[0].MockName,
+			Method:        firstMethod,
+			Signature:     firstMethod.Signature(),
+			TypeParams:    pkgDesc.Interfaces[0].TypeParams,
+			Template:      pkgTmpl,
+			Imports:       imports,
 		}
 
-		out := filepath.Join(filepath.Dir(fp), fileName)
+		err := templateSyrup.WriteImports(buffer, pkgDesc)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-		log.Println(out)
+	for _, interfaceDesc := range pkgDesc.Interfaces {
+		// Write mock base using the template Syrup (or create one if we don't have one)
+		// Create a Syrup for this interface
+		firstMethod := interfaceDesc.Methods[0]
+		baseSyrup := &Syrup{
+			PkgPath:       pkgDesc.Pkg.Path(),
+			InterfaceName: interfaceDesc.Name,
+			MockName:      interfaceDesc.MockName,
+			Method:        firstMethod,
+			Signature:     firstMethod.Signature(),
+			TypeParams:    interfaceDesc.TypeParams,
+			Template:      pkgTmpl,
+			Imports:       imports,
+		}
 
-		err = os.WriteFile(out, source, 0o640)
+		err := baseSyrup.WriteMockBase(buffer, interfaceDesc)
 		if err != nil {
-			return fmt.Errorf("write file: %w", err)
+			return nil, err
+		}
+
+		_, _ = buffer.WriteString("\n")
+
+		for _, method := range interfaceDesc.Methods {
+			syrup := &Syrup{
+				PkgPath:       pkgDesc.Pkg.Path(),
+				InterfaceName: interfaceDesc.Name,
+				MockName:      interfaceDesc.MockName,
+				Method:        method,
+				Signature:     method.Signature(),
+				TypeParams:    interfaceDesc.TypeParams,
+				Template:      pkgTmpl,
+				Imports:       imports,
+			}
+
+			err = syrup.MockMethod(buffer)
+			if err != nil {
+				return nil, err
+			}
+
+			err = syrup.Call(buffer, interfaceDesc.Methods)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
-	return nil
+	return buffer, nil
 }
@@ -1,11 +1,15 @@
 package main
 
 import (
+	"bytes"
+	"fmt"
+	"go/token"
 	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -40,14 +44,14 @@ func TestMocktail(t *testing.T) {
 			return errW
 		}
 
-		if d.IsDir() || d.Name() != outputMockFile {
+		if d.IsDir() || filepath.Ext(d.Name()) != ".golden" {
 			return nil
 		}
 
-		genBytes, err := os.ReadFile(path)
+		genBytes, err := os.ReadFile(strings.TrimSuffix(path, ".golden"))
 		require.NoError(t, err)
 
-		goldenBytes, err := os.ReadFile(path + ".golden")
+		goldenBytes, err := os.ReadFile(path)
 		require.NoError(t, err)
 
 		assert.Equal(t, string(goldenBytes), string(genBytes))
@@ -99,14 +103,14 @@ func TestMocktail_exported(t *testing.T) {
 			return errW
 		}
 
-		if d.IsDir() || d.Name() != outputMockFile {
+		if d.IsDir() || filepath.Ext(d.Name()) != ".golden" {
 			return nil
 		}
 
-		genBytes, err := os.ReadFile(path)
+		genBytes, err := os.ReadFile(strings.TrimSuffix(path, ".golden"))
 		require.NoError(t, err)
 
-		goldenBytes, err := os.ReadFile(path + ".golden")
+		goldenBytes, err := os.ReadFile(path)
 		require.NoError(t, err)
 
 		assert.Equal(t, string(goldenBytes), string(genBytes))
@@ -120,7 +124,8 @@ func TestMocktail_exported(t *testing.T) {
 			continue
 		}
 
-		cmd := exec.CommandContext(t.Context(), "go", "test", "-v", "./...")
+		// The mocks of the module "tagged" are gated by the build constraint "mocks".
+		cmd := exec.CommandContext(t.Context(), "go", "test", "-tags", "mocks", "-v", "./...")
 		cmd.Dir = filepath.Join(testRoot, entry.Name())
 
 		output, err := cmd.CombinedOutput()
@@ -129,3 +134,418 @@ func TestMocktail_exported(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestMocktail_workspace(t *testing.T) {
+	const testRoot = "./testdata/workspace"
+
+	if runtime.GOOS == "windows" {
+		t.Skip(runtime.GOOS)
+	}
+
+	// `-mod=mod` is not allowed in workspace mode.
+	t.Setenv("GOFLAGS", "")
+
+	// All the modules of the workspace are generated, even from a module.
+	t.Setenv("MOCKTAIL_TEST_PATH", filepath.Join(testRoot, "app"))
+
+	output, err := exec.CommandContext(t.Context(), "go", "run", ".").CombinedOutput()
+	t.Log(string(output))
+
+	require.NoError(t, err)
+
+	errW := filepath.WalkDir(testRoot, func(path string, d fs.DirEntry, errW error) error {
+		if errW != nil {
+			return errW
+		}
+
+		if d.IsDir() || filepath.Ext(d.Name()) != ".golden" {
+			return nil
+		}
+
+		genBytes, err := os.ReadFile(strings.TrimSuffix(path, ".golden"))
+		require.NoError(t, err)
+
+		goldenBytes, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		assert.Equal(t, string(goldenBytes), string(genBytes))
+
+		return nil
+	})
+	require.NoError(t, errW)
+
+	for _, module := range []string{"app", "app/nested", "lib"} {
+		cmd := exec.CommandContext(t.Context(), "go", "test", "-v", "./...")
+		cmd.Dir = filepath.Join(testRoot, module)
+
+		output, err := cmd.CombinedOutput()
+		t.Log(string(output))
+
+		require.NoError(t, err)
+	}
+}
+
+func Test_getImportPaths(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		target        string
+		expectedPaths []string
+		expectedName  string
+	}{
+		{
+			desc:          "local interface",
+			target:        "Pineapple",
+			expectedPaths: []string{"a/foo"},
+			expectedName:  "Pineapple",
+		},
+		{
+			desc:          "module package",
+			target:        "b.Carrot",
+			expectedPaths: []string{"b", "a/b"},
+			expectedName:  "Carrot",
+		},
+		{
+			desc:          "stdlib",
+			target:        "net/http.RoundTripper",
+			expectedPaths: []string{"net/http", "a/net/http"},
+			expectedName:  "RoundTripper",
+		},
+		{
+			desc:          "dependency",
+			target:        "github.com/aws/aws-sdk-go-v2/service/s3.Client",
+			expectedPaths: []string{"github.com/aws/aws-sdk-go-v2/service/s3", "a/github.com/aws/aws-sdk-go-v2/service/s3"},
+			expectedName:  "Client",
+		},
+		{
+			desc:          "wildcard",
+			target:        "*",
+			expectedPaths: []string{"a/foo"},
+			expectedName:  "*",
+		},
+		{
+			desc:          "qualified wildcard",
+			target:        "store.*",
+			expectedPaths: []string{"store", "a/store"},
+			expectedName:  "*",
+		},
+		{
+			desc:          "regexp",
+			target:        "/.*Repo$/",
+			expectedPaths: []string{"a/foo"},
+			expectedName:  "/.*Repo$/",
+		},
+		{
+			desc:          "qualified regexp",
+			target:        "gopkg.in/yaml.v3./^Un.*/",
+			expectedPaths: []string{"gopkg.in/yaml.v3", "a/gopkg.in/yaml.v3"},
+			expectedName:  "/^Un.*/",
+		},
+		{
+			desc:          "type arguments",
+			target:        "store.Repository[store.User, map[string]int]",
+			expectedPaths: []string{"store", "a/store"},
+			expectedName:  "Repository",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			importPaths, name := getImportPaths("a", "a/foo", test.target)
+
+			assert.Equal(t, test.expectedPaths, importPaths)
+			assert.Equal(t, test.expectedName, name)
+		})
+	}
+}
+
+func BenchmarkWalk(b *testing.B) {
+	const nbPackages = 50
+
+	root := b.TempDir()
+
+	writeFile(b, filepath.Join(root, "go.mod"), "module bench\n\ngo 1.24\n")
+
+	var importPaths []string
+
+	for i := range nbPackages {
+		name := fmt.Sprintf("pkg%d", i)
+
+		writeFile(b, filepath.Join(root, name, name+".go"), fmt.Sprintf("package %s\n\ntype Service interface {\n\tDo(string) error\n}\n", name))
+		writeFile(b, filepath.Join(root, name, "mock_test.go"), fmt.Sprintf("package %s\n\n// mocktail:Service\n", name))
+
+		importPaths = append(importPaths, "bench/"+name)
+	}
+
+	b.Run("walk", func(b *testing.B) {
+		for b.Loop() {
+			model, _, err := walk(root, "bench", walkOptions{})
+			require.NoError(b, err)
+			require.Len(b, model, nbPackages)
+		}
+	})
+
+	// The previous behavior: one load by directive.
+	b.Run("load by directive", func(b *testing.B) {
+		for b.Loop() {
+			for _, importPath := range importPaths {
+				_, err := loadPackages(root, []string{importPath}, buildOptions{})
+				require.NoError(b, err)
+			}
+		}
+	})
+}
+
+func Test_buildOptions_buildFlags(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		build    buildOptions
+		expected []string
+	}{
+		{desc: "empty", build: buildOptions{}},
+		{desc: "flags", build: buildOptions{Flags: []string{"-mod=vendor"}}, expected: []string{"-mod=vendor"}},
+		{
+			desc:     "tags",
+			build:    buildOptions{Tags: splitTags("enterprise, e2e integration"), Flags: []string{"-mod=vendor"}},
+			expected: []string{"-mod=vendor", "-tags=enterprise,e2e,integration"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, test.build.buildFlags())
+		})
+	}
+}
+
+func Test_walk_strict(t *testing.T) {
+	root := t.TempDir()
+
+	writeFile(t, filepath.Join(root, "go.mod"), "module strict\n\ngo 1.24\n")
+	writeFile(t, filepath.Join(root, "store", "store.go"), "package store\n\ntype UserRepo interface {\n\tFind(string) error\n}\n\ntype User struct{}\n\ntype Empty interface{}\n")
+	writeFile(t, filepath.Join(root, "store", "mock_test.go"), `package store
+
+// mocktail:UsrRepo
+// mocktail:User
+// mocktail:Cache*
+// mocktail:/^Order/
+// mocktail:Empty
+`)
+
+	model, _, err := walk(root, "strict", walkOptions{})
+	require.Error(t, err)
+	assert.Empty(t, model)
+
+	// Only the invalid directives are errors without the strict mode.
+	fp := filepath.Join(root, "store", "mock_test.go")
+	require.EqualError(t, err, fp+`:4: type "strict/store.User" is not an interface or a function type`+"\n"+fp+`:7: interface "strict/store.Empty" has no methods`)
+
+	_, _, err = walk(root, "strict", walkOptions{Strict: true})
+	require.Error(t, err)
+
+	expected := []string{
+		fp + ":3: unable to find UsrRepo, did you mean UserRepo?",
+		fp + `:4: type "strict/store.User" is not an interface or a function type`,
+		fp + ":5: unable to find Cache*",
+		fp + ":6: no interface matches /^Order/",
+		fp + `:7: interface "strict/store.Empty" has no methods`,
+	}
+
+	assert.Equal(t, strings.Join(expected, "\n"), err.Error())
+}
+
+func Test_walk_nameConflicts(t *testing.T) {
+	root := t.TempDir()
+
+	writeFile(t, filepath.Join(root, "go.mod"), "module conflicts\n\ngo 1.24\n")
+	writeFile(t, filepath.Join(root, "a", "a.go"), "package a\n\ntype Foo interface {\n\tBarDo()\n\tDo()\n}\n\ntype Bar interface {\n\tDo()\n}\n")
+	writeFile(t, filepath.Join(root, "a", "mock_test.go"), `package a
+
+// mocktail:Foo
+// mocktail:Bar name=fooMock
+// mocktail:Bar name=foo
+// mocktail:Bar name=barStub constructor=newFooMock
+// mocktail:Bar name=fooBarMock
+// mocktail:Bar
+`)
+
+	model, _, err := walk(root, "conflicts", walkOptions{})
+	require.Error(t, err)
+
+	fp := filepath.Join(root, "a", "mock_test.go")
+
+	expected := []string{
+		fp + `:4: the mock name "fooMock" is already used by conflicts/a.Foo, use the ` + "`name`" + ` option`,
+		fp + `:5: the Call type name "fooDoCall" is already used by conflicts/a.Foo, use the ` + "`name`" + ` option`,
+		fp + `:6: the constructor name "newFooMock" is already used by conflicts/a.Foo, use the ` + "`constructor`" + ` option`,
+		fp + `:7: the Call type name "fooBarDoCall" is already used by conflicts/a.Foo, use the ` + "`name`" + ` option`,
+	}
+
+	assert.Equal(t, strings.Join(expected, "\n"), err.Error())
+
+	pkgDesc := model[filepath.Join(root, "a", outputMockFile)]
+	require.Len(t, pkgDesc.Interfaces, 2)
+	assert.Equal(t, "fooMock", pkgDesc.Interfaces[0].MockName)
+	assert.Equal(t, "barMock", pkgDesc.Interfaces[1].MockName)
+}
+
+func Test_walk_loadErrors(t *testing.T) {
+	root := t.TempDir()
+
+	writeFile(t, filepath.Join(root, "go.mod"), "module broken\n\ngo 1.24\n")
+	writeFile(t, filepath.Join(root, "store", "store.go"), "package store\n\ntype UserRepo interface {\n\tFind(string) Unknown\n}\n")
+	writeFile(t, filepath.Join(root, "store", "mock_test.go"), "package store\n\n// mocktail:UserRepo\n")
+	writeFile(t, filepath.Join(root, "service", "mock_test.go"), "package service\n\n// mocktail:store.UserRepo\n// mocktail:fmt.Stringer\n")
+
+	model, _, err := walk(root, "broken", walkOptions{})
+	require.Error(t, err)
+
+	expected := []string{
+		filepath.Join(root, "service", "mock_test.go") + ":3: broken/store: " + filepath.Join(root, "store", "store.go") + ":4:15: undefined: Unknown",
+		filepath.Join(root, "store", "mock_test.go") + ":3: broken/store: " + filepath.Join(root, "store", "store.go") + ":4:15: undefined: Unknown",
+	}
+
+	assert.Equal(t, strings.Join(expected, "\n"), err.Error())
+
+	// The mocks of the valid packages can still be generated.
+	require.Len(t, model, 1)
+
+	packageDesc := model[filepath.Join(root, "service", outputMockFile)]
+	require.Len(t, packageDesc.Interfaces, 1)
+	assert.Equal(t, "Stringer", packageDesc.Interfaces[0].Name)
+}
+
+func writeFile(tb testing.TB, name, content string) {
+	tb.Helper()
+
+	err := os.MkdirAll(filepath.Dir(name), 0o750)
+	require.NoError(tb, err)
+
+	err = os.WriteFile(name, []byte(content), 0o600)
+	require.NoError(tb, err)
+}
+
+func Test_generate_errors(t *testing.T) {
+	tmpl, err := getTemplate("")
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+
+	model := make(map[string]PackageDesc)
+
+	for _, name := range []string{"b", "a", "c"} {
+		model[filepath.Join(dir, name, outputMockFile)] = createSimpleTestPackage(name)
+	}
+
+	// "a" and "b" are files instead of directories.
+	writeFile(t, filepath.Join(dir, "a"), "")
+	writeFile(t, filepath.Join(dir, "b"), "")
+
+	err = generate(model, tmpl, generateOptions{Workers: 2})
+	require.Error(t, err)
+
+	errA := filepath.Join(dir, "a", outputMockFile) + ": create directory: "
+	errB := filepath.Join(dir, "b", outputMockFile) + ": create directory: "
+
+	assert.Contains(t, err.Error(), errA)
+	assert.Contains(t, err.Error(), errB)
+	assert.Less(t, strings.Index(err.Error(), errA), strings.Index(err.Error(), errB))
+
+	assert.FileExists(t, filepath.Join(dir, "c", outputMockFile))
+}
+
+func Test_generate_handwrittenFile(t *testing.T) {
+	tmpl, err := getTemplate("")
+	require.NoError(t, err)
+
+	out := filepath.Join(t.TempDir(), "mock_test.go")
+
+	content := "package a\n\nfunc TestImportant(t *testing.T) {}\n"
+	writeFile(t, out, content)
+
+	model := map[string]PackageDesc{out: createSimpleTestPackage("a")}
+
+	err = generate(model, tmpl, generateOptions{})
+	require.ErrorContains(t, err, out+": the existing file is not generated by mocktail, it is not overwritten")
+
+	// The file is not reported as a stale mock.
+	err = generate(model, tmpl, generateOptions{Check: true})
+	require.EqualError(t, err, out+": the existing file is not generated by mocktail, it is not overwritten")
+
+	current, err := os.ReadFile(out)
+	require.NoError(t, err)
+
+	assert.Equal(t, content, string(current))
+}
+
+func Test_walk_buildConstraints(t *testing.T) {
+	root := t.TempDir()
+
+	writeFile(t, filepath.Join(root, "go.mod"), "module constrained\n\ngo 1.24\n")
+	writeFile(t, filepath.Join(root, "a", "a.go"), "package a\n\ntype Foo interface {\n\tFoo()\n}\n\ntype Bar interface {\n\tBar()\n}\n")
+	writeFile(t, filepath.Join(root, "a", "e2e_test.go"), "//go:build e2e\n\npackage a\n\n// mocktail:Foo\n// mocktail:Bar file=mock_bar_gen_test.go\n")
+	writeFile(t, filepath.Join(root, "a", "integration_test.go"), "//go:build integration\n\npackage a\n\n// mocktail:Foo\n")
+	writeFile(t, filepath.Join(root, "a", "mock_test.go"), "package a\n\n// mocktail:Bar file=mock_bar_gen_test.go\n")
+
+	model, _, err := walk(root, "constrained", walkOptions{})
+	require.NoError(t, err)
+
+	// The mock of Foo is built with any of its directives, the mock of Bar always.
+	pkgDesc := model[filepath.Join(root, "a", "mock_e2e_or_integration_gen_test.go")]
+	assert.Equal(t, "e2e || integration", pkgDesc.BuildConstraint)
+	require.Len(t, pkgDesc.Interfaces, 1)
+	assert.Equal(t, "Foo", pkgDesc.Interfaces[0].Name)
+
+	pkgDesc = model[filepath.Join(root, "a", "mock_bar_gen_test.go")]
+	assert.Empty(t, pkgDesc.BuildConstraint)
+	require.Len(t, pkgDesc.Interfaces, 1)
+
+	// The mocks of a file must have the same build constraint.
+	writeFile(t, filepath.Join(root, "a", "e2e_test.go"), "//go:build e2e\n\npackage a\n\n// mocktail:Foo file=mock_bar_gen_test.go\n")
+
+	_, _, err = walk(root, "constrained", walkOptions{})
+	require.ErrorContains(t, err, `the mocks of the file `+filepath.Join(root, "a", "mock_bar_gen_test.go")+` have different build constraints ("e2e || integration", "")`)
+}
+
+func Test_generate_check(t *testing.T) {
+	tmpl, err := getTemplate("")
+	require.NoError(t, err)
+
+	out := filepath.Join(t.TempDir(), "a", outputMockFile)
+
+	pkgDesc := createSimpleTestPackage("a")
+	pkgDesc.Interfaces[0].Pos = token.Position{Filename: "mock_test.go", Line: 3}
+
+	model := map[string]PackageDesc{out: pkgDesc}
+
+	// Missing file.
+	var diff bytes.Buffer
+
+	err = generate(model, tmpl, generateOptions{Check: true, Diff: &diff})
+	require.EqualError(t, err, out+": not up to date (directives: mock_test.go:3)")
+	assert.Contains(t, diff.String(), "+++ "+out+" (generated)\n")
+	assert.NoFileExists(t, out)
+
+	// Up to date.
+	err = generate(model, tmpl, generateOptions{})
+	require.NoError(t, err)
+
+	diff.Reset()
+
+	err = generate(model, tmpl, generateOptions{Check: true, Diff: &diff})
+	require.NoError(t, err)
+	assert.Empty(t, diff.String())
+
+	// Stale file.
+	content, err := os.ReadFile(out)
+	require.NoError(t, err)
+
+	writeFile(t, out, strings.Replace(string(content), "package a", "package a\n\n// stale", 1))
+
+	err = generate(model, tmpl, generateOptions{Check: true, Diff: &diff})
+	require.Error(t, err)
+	assert.Contains(t, diff.String(), "\n-// stale\n")
+}
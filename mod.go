@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"golang.org/x/mod/modfile"
@@ -21,26 +24,117 @@ type modInfo struct {
 	Main      bool   `json:"Main"`
 }
 
-func getModuleInfo(ctx context.Context, dir string) (modInfo, error) {
-	cmd := exec.CommandContext(ctx, "go", "env", "-json", "GOMOD")
+// getModules returns the module of the directory.
+// Inside a workspace (`go.work`), all the modules of the workspace are returned from the root of the workspace only.
+func getModules(ctx context.Context, dir string) ([]modInfo, error) {
+	cmd := exec.CommandContext(ctx, "go", "env", "-json", "GOMOD", "GOWORK")
 	if dir != "" {
 		cmd.Dir = dir
 	}
 
 	out, err := cmd.Output()
 	if err != nil {
-		return modInfo{}, fmt.Errorf("command %q: %w: %s", strings.Join(cmd.Args, " "), err, string(out))
+		return nil, fmt.Errorf("command %q: %w: %s", strings.Join(cmd.Args, " "), err, string(out))
 	}
 
 	v := map[string]string{}
 
 	err = json.NewDecoder(bytes.NewBuffer(out)).Decode(&v)
 	if err != nil {
-		return modInfo{}, err
+		return nil, err
+	}
+
+	if v["GOWORK"] != "" && v["GOWORK"] != "off" {
+		modules, err := getWorkspaceModules(v["GOWORK"])
+		if err != nil {
+			return nil, err
+		}
+
+		if dir == "" {
+			dir, err = os.Getwd()
+		} else {
+			dir, err = filepath.Abs(dir)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		return selectWorkspaceModules(modules, filepath.Dir(v["GOWORK"]), dir)
+	}
+
+	if v["GOMOD"] == "" || v["GOMOD"] == os.DevNull {
+		return nil, errors.New("go.mod file not found")
+	}
+
+	info, err := readModuleInfo(v["GOMOD"])
+	if err != nil {
+		return nil, err
 	}
 
-	goModPath := v["GOMOD"]
+	return []modInfo{info}, nil
+}
+
+// getWorkspaceModules returns the modules used by the workspace, in the order of the `go.work`.
+func getWorkspaceModules(goWorkPath string) ([]modInfo, error) {
+	data, err := os.ReadFile(goWorkPath)
+	if err != nil {
+		return nil, err
+	}
+
+	goWorkFile, err := modfile.ParseWork(goWorkPath, data, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var modules []modInfo
+
+	for _, use := range goWorkFile.Use {
+		dir := filepath.FromSlash(use.Path)
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(filepath.Dir(goWorkPath), dir)
+		}
+
+		info, err := readModuleInfo(filepath.Join(dir, "go.mod"))
+		if err != nil {
+			return nil, err
+		}
+
+		modules = append(modules, info)
+	}
+
+	return modules, nil
+}
+
+// selectWorkspaceModules returns all the modules of the workspace from the root of the workspace,
+// or the module containing the directory.
+func selectWorkspaceModules(modules []modInfo, workDir, dir string) ([]modInfo, error) {
+	if dir == workDir {
+		return modules, nil
+	}
+
+	var current *modInfo
+
+	for i, m := range modules {
+		rel, err := filepath.Rel(m.Dir, dir)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+
+		// The innermost module contains the directory.
+		if current == nil || len(m.Dir) > len(current.Dir) {
+			current = &modules[i]
+		}
+	}
+
+	if current == nil {
+		return nil, fmt.Errorf("directory %s is outside the modules of the workspace", dir)
+	}
+
+	return []modInfo{*current}, nil
+}
 
+func readModuleInfo(goModPath string) (modInfo, error) {
 	data, err := os.ReadFile(goModPath)
 	if err != nil {
 		return modInfo{}, err
@@ -51,11 +145,117 @@ func getModuleInfo(ctx context.Context, dir string) (modInfo, error) {
 		return modInfo{}, err
 	}
 
-	return modInfo{
-		Path:      goModFile.Module.Mod.Path,
-		Dir:       filepath.Dir(goModPath),
-		GoMod:     goModPath,
-		GoVersion: goModFile.Go.Version,
-		Main:      true,
-	}, nil
+	if goModFile.Module == nil {
+		return modInfo{}, fmt.Errorf("%s: missing module directive", goModPath)
+	}
+
+	info := modInfo{
+		Path:  goModFile.Module.Mod.Path,
+		Dir:   filepath.Dir(goModPath),
+		GoMod: goModPath,
+		Main:  true,
+	}
+
+	if goModFile.Go != nil {
+		info.GoVersion = goModFile.Go.Version
+	}
+
+	return info, nil
+}
+
+// isModuleDir reports whether the directory contains a `go.mod` file.
+func isModuleDir(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, "go.mod"))
+
+	return err == nil
+}
+
+// walkModule walks a module with the ignore matcher (including the `.gitignore` files of the parent directories),
+// and calls fn with the files that are not ignored.
+// The `testdata` and `vendor` directories, and the ignored directories, are skipped.
+// The nested modules are skipped, unless onModule is not nil: it is called with their directories, and they are walked.
+func walkModule(root string, ignore *ignoreMatcher, fn func(fp string, d fs.DirEntry) error, onModule func(dir string) error) error {
+	err := ignore.loadParents()
+	if err != nil {
+		return err
+	}
+
+	return filepath.WalkDir(root, func(fp string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() {
+			if fn == nil || ignore.match(fp, false) {
+				return nil
+			}
+
+			return fn(fp, d)
+		}
+
+		if d.Name() == "testdata" || d.Name() == "vendor" || ignore.match(fp, true) {
+			return filepath.SkipDir
+		}
+
+		if fp != root && isModuleDir(fp) {
+			// The nested modules are not part of the module.
+			if onModule == nil {
+				return filepath.SkipDir
+			}
+
+			err = onModule(fp)
+			if err != nil {
+				return err
+			}
+		}
+
+		return ignore.load(fp)
+	})
+}
+
+// addNestedModules adds the modules nested inside the modules.
+func addNestedModules(modules []modInfo, excludes []string) ([]modInfo, error) {
+	result := slices.Clone(modules)
+
+	for _, mod := range modules {
+		cfg, err := loadConfig(mod.Dir)
+		if err != nil {
+			return nil, err
+		}
+
+		nested, err := findNestedModules(mod.Dir, append(slices.Clone(excludes), cfg.Exclude...))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, n := range nested {
+			// The modules of a workspace can be nested.
+			if !slices.ContainsFunc(result, func(m modInfo) bool { return m.Dir == n.Dir }) {
+				result = append(result, n)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// findNestedModules returns the modules inside the directory of a module.
+func findNestedModules(root string, excludes []string) ([]modInfo, error) {
+	var modules []modInfo
+
+	err := walkModule(root, newIgnoreMatcher(root, excludes), nil, func(dir string) error {
+		info, err := readModuleInfo(filepath.Join(dir, "go.mod"))
+		if err != nil {
+			return err
+		}
+
+		modules = append(modules, info)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walk dir: %w", err)
+	}
+
+	return modules, nil
 }
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getWorkspaceModules(t *testing.T) {
+	modules, err := getWorkspaceModules(filepath.Join("testdata", "workspace", "go.work"))
+	require.NoError(t, err)
+
+	var paths []string
+	for _, m := range modules {
+		paths = append(paths, m.Path)
+	}
+
+	assert.Equal(t, []string{"example.com/app", "example.com/app-nested", "example.com/lib"}, paths)
+}
+
+func Test_selectWorkspaceModules(t *testing.T) {
+	workDir := filepath.Join("testdata", "workspace")
+
+	modules, err := getWorkspaceModules(filepath.Join(workDir, "go.work"))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc     string
+		dir      string
+		expected []string
+	}{
+		{
+			desc:     "workspace root",
+			dir:      workDir,
+			expected: []string{"example.com/app", "example.com/app-nested", "example.com/lib"},
+		},
+		{
+			desc:     "module",
+			dir:      filepath.Join(workDir, "lib"),
+			expected: []string{"example.com/lib"},
+		},
+		{
+			desc:     "sub-directory of a module",
+			dir:      filepath.Join(workDir, "app", "service"),
+			expected: []string{"example.com/app"},
+		},
+		{
+			desc:     "nested module",
+			dir:      filepath.Join(workDir, "app", "nested"),
+			expected: []string{"example.com/app-nested"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			selected, err := selectWorkspaceModules(modules, workDir, test.dir)
+			require.NoError(t, err)
+
+			var paths []string
+			for _, m := range selected {
+				paths = append(paths, m.Path)
+			}
+
+			assert.Equal(t, test.expected, paths)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// outputPackageData contains the data of the output package pattern (ex: `../{{ .PackageName }}mock`).
+type outputPackageData struct {
+	PackageName string
+}
+
+// getOutputPackage returns the package, and its directory, where the mocks of a package are generated.
+// The pattern is a directory relative to the directory of the package, it can contain the name of the package (`{{ .PackageName }}`).
+func getOutputPackage(root, dir string, pkg *types.Package, pattern string) (*types.Package, string, error) {
+	if pattern == "" {
+		return pkg, dir, nil
+	}
+
+	rel, err := executeOutputPackagePattern(pattern, pkg.Name())
+	if err != nil {
+		return nil, "", err
+	}
+
+	if filepath.IsAbs(rel) {
+		return nil, "", fmt.Errorf("invalid output package %q: must be relative to the package directory", pattern)
+	}
+
+	outDir := filepath.Join(dir, filepath.FromSlash(rel))
+
+	relRoot, err := filepath.Rel(root, outDir)
+	if err != nil || relRoot == ".." || strings.HasPrefix(relRoot, ".."+string(filepath.Separator)) {
+		return nil, "", fmt.Errorf("invalid output package %q: outside of the module", pattern)
+	}
+
+	name, err := getOutputPackageName(outDir)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return types.NewPackage(path.Join(pkg.Path(), filepath.ToSlash(rel)), name), outDir, nil
+}
+
+func executeOutputPackagePattern(pattern, pkgName string) (string, error) {
+	if !strings.Contains(pattern, "{{") {
+		return pattern, nil
+	}
+
+	tmpl, err := template.New("package").Funcs(templateFuncs).Parse(pattern)
+	if err != nil {
+		return "", fmt.Errorf("invalid output package %q: %w", pattern, err)
+	}
+
+	var buffer bytes.Buffer
+
+	err = tmpl.Execute(&buffer, outputPackageData{PackageName: pkgName})
+	if err != nil {
+		return "", fmt.Errorf("invalid output package %q: %w", pattern, err)
+	}
+
+	return buffer.String(), nil
+}
+
+// getOutputPackageName returns the name of the package of the existing Go files of the directory,
+// or a name based on the directory name.
+func getOutputPackageName(dir string) (string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", err
+	}
+
+	for _, fp := range matches {
+		if strings.HasSuffix(fp, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(token.NewFileSet(), fp, nil, parser.PackageClauseOnly)
+		if err != nil {
+			return "", fmt.Errorf("parse package clause: %w", err)
+		}
+
+		return file.Name.Name, nil
+	}
+
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			return unicode.ToLower(r)
+		}
+
+		return -1
+	}, filepath.Base(dir))
+
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "_" + name
+	}
+
+	return name, nil
+}
+
+// checkExported checks that the methods of a mock only use types that can be referenced from the output package.
+func checkExported(interfaceDesc InterfaceDesc, methods []*types.Func, pkgPath string) error {
+	for _, method := range methods {
+		if !method.Exported() && method.Pkg() != nil && method.Pkg().Path() != pkgPath {
+			return fmt.Errorf("the method %s of %s is not exported", method.Name(), interfaceDesc.Name)
+		}
+
+		if obj := unexportedType(method.Signature(), pkgPath); obj != nil {
+			return fmt.Errorf("the %s used by the method %s of %s is not exported", describeObject(obj), method.Name(), interfaceDesc.Name)
+		}
+	}
+
+	if interfaceDesc.FuncType != nil {
+		if obj := unexportedType(interfaceDesc.FuncType, pkgPath); obj != nil {
+			return fmt.Errorf("the %s is not exported", describeObject(obj))
+		}
+	}
+
+	// The constraints are written in the type parameters of the mock.
+	if interfaceDesc.TypeParams != nil {
+		for tp := range interfaceDesc.TypeParams.TypeParams() {
+			if obj := unexportedType(tp.Constraint(), pkgPath); obj != nil {
+				return fmt.Errorf("the %s used by the constraint of the type parameter %s of %s is not exported", describeObject(obj), tp.Obj().Name(), interfaceDesc.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func describeObject(obj types.Object) string {
+	switch v := obj.(type) {
+	case *types.Var:
+		return fmt.Sprintf("field %s.%s", v.Pkg().Path(), v.Name())
+	case *types.Func:
+		return fmt.Sprintf("method %s.%s", v.Pkg().Path(), v.Name())
+	default:
+		return fmt.Sprintf("type %s.%s", obj.Pkg().Path(), obj.Name())
+	}
+}
+
+// unexportedType returns the first object of a type that cannot be referenced from a package:
+// an unexported named type, or an unexported field of an anonymous struct, declared in another package.
+func unexportedType(t types.Type, pkgPath string) types.Object {
+	var unexported types.Object
+
+	walkTypeObjects(t, func(obj types.Object) bool {
+		if !obj.Exported() && obj.Pkg() != nil && obj.Pkg().Path() != pkgPath {
+			unexported = obj
+			return false
+		}
+
+		return true
+	})
+
+	return unexported
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"go/types"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getOutputPackage(t *testing.T) {
+	root := t.TempDir()
+
+	writeFile(t, filepath.Join(root, "existing", "doc.go"), "package mocks\n")
+
+	pkg := types.NewPackage("a/store", "store")
+	dir := filepath.Join(root, "store")
+
+	testCases := []struct {
+		desc         string
+		pattern      string
+		expectedPath string
+		expectedName string
+		expectedDir  string
+	}{
+		{
+			desc:         "same package",
+			expectedPath: "a/store",
+			expectedName: "store",
+			expectedDir:  dir,
+		},
+		{
+			desc:         "sub-package",
+			pattern:      "mocks",
+			expectedPath: "a/store/mocks",
+			expectedName: "mocks",
+			expectedDir:  filepath.Join(dir, "mocks"),
+		},
+		{
+			desc:         "sibling package",
+			pattern:      "../{{ .PackageName }}-mock",
+			expectedPath: "a/store-mock",
+			expectedName: "storemock",
+			expectedDir:  filepath.Join(root, "store-mock"),
+		},
+		{
+			desc:         "existing package",
+			pattern:      "../existing",
+			expectedPath: "a/existing",
+			expectedName: "mocks",
+			expectedDir:  filepath.Join(root, "existing"),
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			outPkg, outDir, err := getOutputPackage(root, dir, pkg, test.pattern)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedPath, outPkg.Path())
+			assert.Equal(t, test.expectedName, outPkg.Name())
+			assert.Equal(t, test.expectedDir, outDir)
+		})
+	}
+}
+
+func Test_getOutputPackage_error(t *testing.T) {
+	root := t.TempDir()
+
+	pkg := types.NewPackage("a/store", "store")
+
+	_, _, err := getOutputPackage(root, filepath.Join(root, "store"), pkg, "../../mocks")
+	require.EqualError(t, err, `invalid output package "../../mocks": outside of the module`)
+
+	_, _, err = getOutputPackage(root, filepath.Join(root, "store"), pkg, "{{ .Foo }}")
+	require.ErrorContains(t, err, `invalid output package "{{ .Foo }}": `)
+}
+
+func Test_checkExported(t *testing.T) {
+	pkg := typeCheck(t, "a/store", `package store
+
+type user struct{}
+
+type User struct{}
+
+type Repo interface {
+	Find(name string) (User, error)
+}
+
+type InternalRepo interface {
+	Find(name string) (*user, error)
+}
+
+type LegacyRepo interface {
+	find(name string) User
+}
+
+type AnonymousRepo interface {
+	Find(name string) struct{ id int }
+}
+
+type number interface {
+	~int | ~float64
+}
+
+type GenericRepo[T number] interface {
+	Find(id T) error
+}
+`)
+
+	testCases := []struct {
+		desc     string
+		name     string
+		pkgPath  string
+		expected string
+	}{
+		{
+			desc:    "exported",
+			name:    "Repo",
+			pkgPath: "a/storemock",
+		},
+		{
+			desc:    "same package",
+			name:    "InternalRepo",
+			pkgPath: "a/store",
+		},
+		{
+			desc:     "unexported type",
+			name:     "InternalRepo",
+			pkgPath:  "a/storemock",
+			expected: "the type a/store.user used by the method Find of InternalRepo is not exported",
+		},
+		{
+			desc:     "unexported method",
+			name:     "LegacyRepo",
+			pkgPath:  "a/storemock",
+			expected: "the method find of LegacyRepo is not exported",
+		},
+		{
+			desc:     "unexported field",
+			name:     "AnonymousRepo",
+			pkgPath:  "a/storemock",
+			expected: "the field a/store.id used by the method Find of AnonymousRepo is not exported",
+		},
+		{
+			desc:     "unexported constraint",
+			name:     "GenericRepo",
+			pkgPath:  "a/storemock",
+			expected: "the type a/store.number used by the constraint of the type parameter T of GenericRepo is not exported",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			obj := pkg.Scope().Lookup(test.name)
+			itf, ok := obj.Type().Underlying().(*types.Interface)
+			require.True(t, ok)
+
+			var methods []*types.Func
+			for method := range itf.Methods() {
+				methods = append(methods, method)
+			}
+
+			named, ok := obj.Type().(*types.Named)
+			require.True(t, ok)
+
+			err := checkExported(InterfaceDesc{Name: test.name, TypeParams: named.TypeParams()}, methods, test.pkgPath)
+			if test.expected == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// unresolvedError returns the error of a directive without interface, with the closest type name of the packages.
+func unresolvedError(d directive, pkgs map[string]*packages.Package, name string, importPaths []string) error {
+	if isNamePattern(name) {
+		return fmt.Errorf("%s: no interface matches %s", d.Pos, d.Target)
+	}
+
+	var names []string
+
+	for _, importPath := range importPaths {
+		pkg, ok := pkgs[importPath]
+		if !ok || pkg.Types == nil {
+			continue
+		}
+
+		scope := pkg.Types.Scope()
+		for _, n := range scope.Names() {
+			if _, ok := scope.Lookup(n).(*types.TypeName); ok {
+				names = append(names, n)
+			}
+		}
+	}
+
+	// None of the candidate packages exists.
+	if len(names) == 0 {
+		if pkg, ok := pkgs[importPaths[0]]; ok && len(pkg.Errors) > 0 {
+			return fmt.Errorf("%s: unable to find %s: %s", d.Pos, d.Target, pkg.Errors[0].Msg)
+		}
+	}
+
+	suggestion := closestName(name, names)
+	if suggestion == "" {
+		return fmt.Errorf("%s: unable to find %s", d.Pos, d.Target)
+	}
+
+	return fmt.Errorf("%s: unable to find %s, did you mean %s?", d.Pos, d.Target, suggestion)
+}
+
+// closestName returns the closest name by edit distance, or an empty string if no name is close enough.
+// The names are expected to be sorted: the first of the closest names is returned.
+func closestName(name string, names []string) string {
+	var closest string
+
+	// More than half of the name must be kept.
+	best := len(name)/2 + 1
+
+	for _, candidate := range names {
+		distance := levenshtein(strings.ToLower(name), strings.ToLower(candidate))
+		if distance < best {
+			best = distance
+			closest = candidate
+		}
+	}
+
+	return closest
+}
+
+// levenshtein computes the edit distance between two strings.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
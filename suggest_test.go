@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_levenshtein(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "", b: "", expected: 0},
+		{a: "abc", b: "", expected: 3},
+		{a: "UserRepo", b: "UserRepo", expected: 0},
+		{a: "UsrRepo", b: "UserRepo", expected: 1},
+		{a: "kitten", b: "sitting", expected: 3},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.a+"/"+test.b, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, levenshtein(test.a, test.b))
+		})
+	}
+}
+
+func Test_closestName(t *testing.T) {
+	names := []string{"Cache", "OrderRepo", "User", "UserRepo"}
+
+	testCases := []struct {
+		desc     string
+		name     string
+		expected string
+	}{
+		{desc: "typo", name: "UsrRepo", expected: "UserRepo"},
+		{desc: "case", name: "userrepo", expected: "UserRepo"},
+		{desc: "too far", name: "Store", expected: ""},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, closestName(test.name, names))
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"go/types"
 	"io"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -15,9 +16,16 @@ import (
 //go:embed templates.go.tmpl
 var templatesFS embed.FS
 
+var templateFuncs = template.FuncMap{
+	"ToGoCamel":  strcase.ToGoCamel,
+	"ToGoPascal": strcase.ToGoPascal,
+}
+
 // BaseTemplateData contains the most commonly used template fields.
 type BaseTemplateData struct {
 	InterfaceName string
+	MockName      string
+	CallPrefix    string // prefix of the Call types.
 	MethodName    string
 	TypeParamsUse string
 }
@@ -51,16 +59,22 @@ type TypeParamsInfo struct {
 
 // ImportsData contains data for imports template.
 type ImportsData struct {
-	Name    string
-	Imports []string
+	Name            string
+	Imports         []string
+	Aliases         map[string]string // Explicit names of the imports, by path.
+	BuildConstraint string            // `//go:build` expression.
 }
 
 // MockBaseData contains data for mockBase template.
 type MockBaseData struct {
 	InterfaceName     string
+	MockName          string
+	ConstructorName   string
 	ConstructorPrefix string
 	TypeParamsDecl    string
 	TypeParamsUse     string
+	FuncType          string // Set for the mocks of function types.
+	FuncMethodName    string
 }
 
 // CombinedCallData contains all data needed for Call template execution.
@@ -94,10 +108,12 @@ type CombinedMockMethodData struct {
 type Syrup struct {
 	PkgPath       string
 	InterfaceName string
+	MockName      string // Default to the mock name based on the interface name.
 	Method        *types.Func
 	Signature     *types.Signature
 	TypeParams    *types.TypeParamList
 	Template      *template.Template
+	Imports       *importRegistry // Names of the imported packages.
 }
 
 // Call generates mock.Call wrapper.
@@ -115,7 +131,7 @@ func (s Syrup) Call(writer io.Writer, methods []*types.Func) error {
 
 		for i := range s.TypeParams.Len() {
 			tp := s.TypeParams.At(i)
-			params = append(params, tp.Obj().Name()+" "+tp.Constraint().String())
+			params = append(params, tp.Obj().Name()+" "+s.getTypeName(tp.Constraint(), false))
 			names = append(names, tp.Obj().Name())
 		}
 
@@ -184,11 +200,13 @@ func (s Syrup) Call(writer io.Writer, methods []*types.Func) error {
 		})
 	}
 
-	callType := fmt.Sprintf("%s%sCall%s", strcase.ToGoCamel(s.InterfaceName), s.Method.Name(), typeParamsUse)
+	callType := fmt.Sprintf("%s%sCall%s", s.getCallPrefix(), s.Method.Name(), typeParamsUse)
 
 	data := CombinedCallData{
 		BaseTemplateData: BaseTemplateData{
 			InterfaceName: s.InterfaceName,
+			MockName:      s.getMockName(),
+			CallPrefix:    s.getCallPrefix(),
 			MethodName:    s.Method.Name(),
 			TypeParamsUse: typeParamsUse,
 		},
@@ -259,6 +277,8 @@ func (s Syrup) MockMethod(writer io.Writer) error {
 	data := CombinedMockMethodData{
 		BaseTemplateData: BaseTemplateData{
 			InterfaceName: s.InterfaceName,
+			MockName:      s.getMockName(),
+			CallPrefix:    s.getCallPrefix(),
 			MethodName:    s.Method.Name(),
 			TypeParamsUse: s.getTypeParamsUse(),
 		},
@@ -276,20 +296,32 @@ func (s Syrup) MockMethod(writer io.Writer) error {
 // WriteImports generates package imports using the Syrup's template.
 func (s Syrup) WriteImports(writer io.Writer, descPkg PackageDesc) error {
 	data := ImportsData{
-		Name:    descPkg.Pkg.Name(),
-		Imports: quickGoImports(descPkg),
+		Name:            descPkg.Pkg.Name(),
+		Imports:         quickGoImports(descPkg),
+		Aliases:         s.Imports.aliases(),
+		BuildConstraint: descPkg.BuildConstraint,
 	}
 
 	return s.Template.ExecuteTemplate(writer, "imports", data)
 }
 
 // WriteMockBase generates mock base struct and constructor using the Syrup's template.
-func (s Syrup) WriteMockBase(writer io.Writer, interfaceDesc InterfaceDesc, exported bool) error {
+func (s Syrup) WriteMockBase(writer io.Writer, interfaceDesc InterfaceDesc) error {
 	constructorPrefix := "new"
-	if exported {
+	if interfaceDesc.Exported {
 		constructorPrefix = "New"
 	}
 
+	mockName := interfaceDesc.MockName
+	if mockName == "" {
+		mockName = getMockName(interfaceDesc.Name, false)
+	}
+
+	constructorName := interfaceDesc.ConstructorName
+	if constructorName == "" {
+		constructorName = getConstructorName(interfaceDesc.Name, interfaceDesc.Exported)
+	}
+
 	// Generate type parameter declarations and usage
 	typeParamsDecl := ""
 	typeParamsUse := ""
@@ -300,7 +332,7 @@ func (s Syrup) WriteMockBase(writer io.Writer, interfaceDesc InterfaceDesc, expo
 
 		for i := range interfaceDesc.TypeParams.Len() {
 			tp := interfaceDesc.TypeParams.At(i)
-			params = append(params, tp.Obj().Name()+" "+tp.Constraint().String())
+			params = append(params, tp.Obj().Name()+" "+s.getTypeName(tp.Constraint(), false))
 			names = append(names, tp.Obj().Name())
 		}
 
@@ -310,14 +342,40 @@ func (s Syrup) WriteMockBase(writer io.Writer, interfaceDesc InterfaceDesc, expo
 
 	data := MockBaseData{
 		InterfaceName:     interfaceDesc.Name,
+		MockName:          mockName,
+		ConstructorName:   constructorName,
 		ConstructorPrefix: constructorPrefix,
 		TypeParamsDecl:    typeParamsDecl,
 		TypeParamsUse:     typeParamsUse,
 	}
 
+	if interfaceDesc.FuncType != nil {
+		data.FuncType = s.getNamedTypeName(interfaceDesc.FuncType)
+
+		// The function type of a generic mock is not instantiated.
+		if interfaceDesc.FuncType.TypeArgs().Len() == 0 {
+			data.FuncType += typeParamsUse
+		}
+		data.FuncMethodName = funcMethodName
+	}
+
 	return s.Template.ExecuteTemplate(writer, "mockBase", data)
 }
 
+// getMockName returns the name of the mock type.
+func (s Syrup) getMockName() string {
+	if s.MockName != "" {
+		return s.MockName
+	}
+
+	return getMockName(s.InterfaceName, false)
+}
+
+// getCallPrefix returns the prefix of the Call types: the mock name without the `Mock` suffix.
+func (s Syrup) getCallPrefix() string {
+	return getCallPrefix(s.getMockName())
+}
+
 // getTypeParamsUse returns type parameters for usage in method receivers.
 func (s Syrup) getTypeParamsUse() string {
 	if s.TypeParams == nil || s.TypeParams.Len() == 0 {
@@ -352,23 +410,20 @@ func (s Syrup) getTypeName(t types.Type, last bool) string {
 	case *types.Named:
 		return s.getNamedTypeName(v)
 
+	case *types.Alias:
+		return s.getAliasTypeName(v)
+
 	case *types.Pointer:
 		return "*" + s.getTypeName(v.Elem(), false)
 
 	case *types.Struct:
-		return v.String()
+		return s.getStructTypeName(v)
 
 	case *types.Interface:
-		return v.String()
+		return s.getInterfaceTypeName(v)
 
 	case *types.Signature:
-		fn := "func(" + strings.Join(s.getTupleTypes(v.Params()), ",") + ")"
-
-		if v.Results().Len() > 0 {
-			fn += " (" + strings.Join(s.getTupleTypes(v.Results()), ",") + ")"
-		}
-
-		return fn
+		return "func" + s.getSignatureTypeName(v)
 
 	case *types.Chan:
 		return s.getChanTypeName(v)
@@ -379,6 +434,9 @@ func (s Syrup) getTypeName(t types.Type, last bool) string {
 	case *types.TypeParam:
 		return v.Obj().Name()
 
+	case *types.Union:
+		return s.getUnionTypeName(v)
+
 	default:
 		panic(fmt.Sprintf("OOPS %[1]T %[1]s", t))
 	}
@@ -393,13 +451,108 @@ func (s Syrup) getTupleTypes(t *types.Tuple) []string {
 	return tupleTypes
 }
 
+// getSignatureTypeName returns the parameters and the results of a signature (ex: `(string, ...int) (bool,error)`).
+func (s Syrup) getSignatureTypeName(t *types.Signature) string {
+	params := s.getTupleTypes(t.Params())
+
+	// The variadic parameter is written with its element type.
+	if t.Variadic() {
+		last := t.Params().At(t.Params().Len() - 1)
+		params[len(params)-1] = "..." + s.getTypeName(last.Type().(*types.Slice).Elem(), false)
+	}
+
+	fn := "(" + strings.Join(params, ",") + ")"
+
+	if t.Results().Len() > 0 {
+		fn += " (" + strings.Join(s.getTupleTypes(t.Results()), ",") + ")"
+	}
+
+	return fn
+}
+
+// getStructTypeName returns an anonymous struct, with its embedded fields and its tags (ex: `struct{ store.User; ID int }`).
+func (s Syrup) getStructTypeName(t *types.Struct) string {
+	if t.NumFields() == 0 {
+		return "struct{}"
+	}
+
+	var fields []string
+
+	for i := range t.NumFields() {
+		field := t.Field(i)
+
+		typ := s.getTypeName(field.Type(), false)
+		if !field.Embedded() {
+			typ = field.Name() + " " + typ
+		}
+
+		if tag := t.Tag(i); tag != "" {
+			typ += " " + quoteTag(tag)
+		}
+
+		fields = append(fields, typ)
+	}
+
+	return "struct{ " + strings.Join(fields, "; ") + " }"
+}
+
+// quoteTag returns a struct tag as a raw string literal, or as an interpreted string literal when the tag contains a backquote.
+func quoteTag(tag string) string {
+	if strings.Contains(tag, "`") {
+		return strconv.Quote(tag)
+	}
+
+	return "`" + tag + "`"
+}
+
+// getInterfaceTypeName returns an interface literal, with its methods and its embedded types (ex: `interface{ fmt.Stringer; Close() (error) }`).
+func (s Syrup) getInterfaceTypeName(t *types.Interface) string {
+	// The implicit interface of a constraint is written as its type set (ex: `[T ~int | ~string]`).
+	if t.IsImplicit() && t.NumEmbeddeds() == 1 {
+		return s.getTypeName(t.EmbeddedType(0), false)
+	}
+
+	if t.NumEmbeddeds() == 0 && t.NumExplicitMethods() == 0 {
+		return "interface{}"
+	}
+
+	var elems []string
+
+	for embedded := range t.EmbeddedTypes() {
+		elems = append(elems, s.getTypeName(embedded, false))
+	}
+
+	for method := range t.ExplicitMethods() {
+		elems = append(elems, method.Name()+s.getSignatureTypeName(method.Type().(*types.Signature)))
+	}
+
+	return "interface{ " + strings.Join(elems, "; ") + " }"
+}
+
+// getUnionTypeName returns the terms of a union of a constraint (ex: `~int | ~string | store.ID`).
+func (s Syrup) getUnionTypeName(t *types.Union) string {
+	var terms []string
+
+	for term := range t.Terms() {
+		name := s.getTypeName(term.Type(), false)
+		if term.Tilde() {
+			name = "~" + name
+		}
+
+		terms = append(terms, name)
+	}
+
+	return strings.Join(terms, " | ")
+}
+
+// getNamedTypeName returns the name of a named type, with its type arguments (ex: `store.Page[store.User]`).
 func (s Syrup) getNamedTypeName(t *types.Named) string {
 	if t.Obj() != nil && t.Obj().Pkg() != nil {
 		if t.Obj().Pkg().Path() == s.PkgPath {
-			return t.Obj().Name()
+			return t.Obj().Name() + s.getTypeArgs(t.TypeArgs())
 		}
 
-		return t.Obj().Pkg().Name() + "." + t.Obj().Name()
+		return s.Imports.name(t.Obj().Pkg()) + "." + t.Obj().Name() + s.getTypeArgs(t.TypeArgs())
 	}
 
 	name := t.String()
@@ -412,6 +565,18 @@ func (s Syrup) getNamedTypeName(t *types.Named) string {
 	return name
 }
 
+// getAliasTypeName returns the name of an alias, with its type arguments (ex: `store.Set[string]`).
+func (s Syrup) getAliasTypeName(t *types.Alias) string {
+	name := t.Obj().Name()
+
+	// Predeclared aliases (ex: `any`) and aliases of the package.
+	if pkg := t.Obj().Pkg(); pkg != nil && pkg.Path() != s.PkgPath {
+		name = s.Imports.name(pkg) + "." + name
+	}
+
+	return name + s.getTypeArgs(t.TypeArgs())
+}
+
 func (s Syrup) getChanTypeName(t *types.Chan) string {
 	var typ string
 
@@ -499,6 +664,30 @@ func quickGoImports(descPkg PackageDesc) []string {
 	return imports
 }
 
+// getCallPrefix returns the prefix of the Call types of a mock (ex: `fooMock` -> `foo`, `fooDoCall`).
+func getCallPrefix(mockName string) string {
+	return strings.TrimSuffix(mockName, "Mock")
+}
+
+// getMockName returns the default name of the mock type of an interface.
+// The type of a mock generated in another package is exported: it can be used by the callers (ex: `UserRepoMock`).
+func getMockName(interfaceName string, exportedType bool) string {
+	if exportedType {
+		return strcase.ToGoPascal(interfaceName) + "Mock"
+	}
+
+	return strcase.ToGoCamel(interfaceName) + "Mock"
+}
+
+// getConstructorName returns the default name of the constructor of the mock of an interface.
+func getConstructorName(interfaceName string, exported bool) string {
+	if exported {
+		return "New" + strcase.ToGoPascal(interfaceName) + "Mock"
+	}
+
+	return "new" + strcase.ToGoPascal(interfaceName) + "Mock"
+}
+
 func getParamName(tVar *types.Var, i int) string {
 	if tVar.Name() == "" {
 		return fmt.Sprintf("%sParam", string(rune('a'+i)))
@@ -516,10 +705,7 @@ func getResultName(tVar *types.Var, i int) string {
 }
 
 func getTemplate(templateFile string) (*template.Template, error) {
-	base := template.New("templates").Funcs(template.FuncMap{
-		"ToGoCamel":  strcase.ToGoCamel,
-		"ToGoPascal": strcase.ToGoPascal,
-	})
+	base := template.New("templates").Funcs(templateFuncs)
 
 	if templateFile != "" {
 		// Use custom template file
@@ -106,6 +106,15 @@ func createSimpleTestMethods() []*types.Func {
 	return []*types.Func{findByName, count}
 }
 
+// createSimpleTestPackage creates the description of a package with a mock of the simple test methods.
+func createSimpleTestPackage(name string) PackageDesc {
+	return PackageDesc{
+		Pkg:        types.NewPackage(name, name),
+		Imports:    map[string]struct{}{},
+		Interfaces: []InterfaceDesc{{Name: "Foo", MockName: "fooMock", Methods: createSimpleTestMethods()}},
+	}
+}
+
 func TestSyrup_TemplateExecution(t *testing.T) {
 	t.Parallel()
 
@@ -383,3 +392,32 @@ func TestSyrup_TemplateErrorHandling(t *testing.T) {
 		})
 	}
 }
+
+func Test_quoteTag(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc     string
+		tag      string
+		expected string
+	}{
+		{
+			desc:     "raw string",
+			tag:      `json:"id"`,
+			expected: "`json:\"id\"`",
+		},
+		{
+			desc:     "backquote",
+			tag:      "doc:\"`id`\"",
+			expected: "\"doc:\\\"`id`\\\"\"",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, quoteTag(test.tag))
+		})
+	}
+}
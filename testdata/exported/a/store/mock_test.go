@@ -0,0 +1,29 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"a/store"
+	"a/storemock"
+)
+
+// mocktail:UserRepo package=../{{ .PackageName }}mock
+// mocktail:Clock package=../storemock
+
+func TestUserRepo(t *testing.T) {
+	// The mocks generated in another package are exported.
+	var repoMock *storemock.UserRepoMock = storemock.NewUserRepoMock(t)
+
+	var repo store.UserRepo = repoMock.
+		OnFind("bob").TypedReturns(store.User{Name: "bob"}, nil).Once().
+		Parent
+
+	_, _ = repo.Find(context.Background(), "bob")
+
+	var clock store.Clock = storemock.NewClockMock(t).
+		OnExecute().TypedReturns(1).Once().
+		Parent.Func()
+
+	clock()
+}
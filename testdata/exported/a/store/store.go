@@ -0,0 +1,14 @@
+package store
+
+import "context"
+
+type User struct {
+	Name string
+}
+
+type UserRepo interface {
+	Find(ctx context.Context, name string) (User, error)
+	Save(ctx context.Context, user *User) error
+}
+
+type Clock func() int64
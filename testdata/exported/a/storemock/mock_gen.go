@@ -0,0 +1,336 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package storemock
+
+import (
+	"a/store"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// UserRepoMock mock of UserRepo.
+type UserRepoMock struct{ mock.Mock }
+
+// NewUserRepoMock creates a new UserRepoMock.
+func NewUserRepoMock(tb testing.TB) *UserRepoMock {
+	tb.Helper()
+
+	m := &UserRepoMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *UserRepoMock) Find(_ context.Context, name string) (store.User, error) {
+	_ret := _m.Called(name)
+
+	if _rf, ok := _ret.Get(0).(func(string) (store.User, error)); ok {
+		return _rf(name)
+	}
+
+	_ra0, _ := _ret.Get(0).(store.User)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *UserRepoMock) OnFind(name string) *UserRepoFindCall {
+	return &UserRepoFindCall{Call: _m.Mock.On("Find", name), Parent: _m}
+}
+
+func (_m *UserRepoMock) OnFindRaw(name interface{}) *UserRepoFindCall {
+	return &UserRepoFindCall{Call: _m.Mock.On("Find", name), Parent: _m}
+}
+
+type UserRepoFindCall struct {
+	*mock.Call
+	Parent *UserRepoMock
+}
+
+func (_c *UserRepoFindCall) Panic(msg string) *UserRepoFindCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *UserRepoFindCall) Once() *UserRepoFindCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *UserRepoFindCall) Twice() *UserRepoFindCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *UserRepoFindCall) Times(i int) *UserRepoFindCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *UserRepoFindCall) WaitUntil(w <-chan time.Time) *UserRepoFindCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *UserRepoFindCall) After(d time.Duration) *UserRepoFindCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *UserRepoFindCall) Run(fn func(args mock.Arguments)) *UserRepoFindCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *UserRepoFindCall) Maybe() *UserRepoFindCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *UserRepoFindCall) TypedReturns(a store.User, b error) *UserRepoFindCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *UserRepoFindCall) ReturnsFn(fn func(string) (store.User, error)) *UserRepoFindCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *UserRepoFindCall) TypedRun(fn func(string)) *UserRepoFindCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_name := args.String(0)
+		fn(_name)
+	})
+	return _c
+}
+
+func (_c *UserRepoFindCall) OnFind(name string) *UserRepoFindCall {
+	return _c.Parent.OnFind(name)
+}
+
+func (_c *UserRepoFindCall) OnSave(user *store.User) *UserRepoSaveCall {
+	return _c.Parent.OnSave(user)
+}
+
+func (_c *UserRepoFindCall) OnFindRaw(name interface{}) *UserRepoFindCall {
+	return _c.Parent.OnFindRaw(name)
+}
+
+func (_c *UserRepoFindCall) OnSaveRaw(user interface{}) *UserRepoSaveCall {
+	return _c.Parent.OnSaveRaw(user)
+}
+
+func (_m *UserRepoMock) Save(_ context.Context, user *store.User) error {
+	_ret := _m.Called(user)
+
+	if _rf, ok := _ret.Get(0).(func(*store.User) error); ok {
+		return _rf(user)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *UserRepoMock) OnSave(user *store.User) *UserRepoSaveCall {
+	return &UserRepoSaveCall{Call: _m.Mock.On("Save", user), Parent: _m}
+}
+
+func (_m *UserRepoMock) OnSaveRaw(user interface{}) *UserRepoSaveCall {
+	return &UserRepoSaveCall{Call: _m.Mock.On("Save", user), Parent: _m}
+}
+
+type UserRepoSaveCall struct {
+	*mock.Call
+	Parent *UserRepoMock
+}
+
+func (_c *UserRepoSaveCall) Panic(msg string) *UserRepoSaveCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *UserRepoSaveCall) Once() *UserRepoSaveCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *UserRepoSaveCall) Twice() *UserRepoSaveCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *UserRepoSaveCall) Times(i int) *UserRepoSaveCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *UserRepoSaveCall) WaitUntil(w <-chan time.Time) *UserRepoSaveCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *UserRepoSaveCall) After(d time.Duration) *UserRepoSaveCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *UserRepoSaveCall) Run(fn func(args mock.Arguments)) *UserRepoSaveCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *UserRepoSaveCall) Maybe() *UserRepoSaveCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *UserRepoSaveCall) TypedReturns(a error) *UserRepoSaveCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *UserRepoSaveCall) ReturnsFn(fn func(*store.User) error) *UserRepoSaveCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *UserRepoSaveCall) TypedRun(fn func(*store.User)) *UserRepoSaveCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_user, _ := args.Get(0).(*store.User)
+		fn(_user)
+	})
+	return _c
+}
+
+func (_c *UserRepoSaveCall) OnFind(name string) *UserRepoFindCall {
+	return _c.Parent.OnFind(name)
+}
+
+func (_c *UserRepoSaveCall) OnSave(user *store.User) *UserRepoSaveCall {
+	return _c.Parent.OnSave(user)
+}
+
+func (_c *UserRepoSaveCall) OnFindRaw(name interface{}) *UserRepoFindCall {
+	return _c.Parent.OnFindRaw(name)
+}
+
+func (_c *UserRepoSaveCall) OnSaveRaw(user interface{}) *UserRepoSaveCall {
+	return _c.Parent.OnSaveRaw(user)
+}
+
+// ClockMock mock of Clock.
+type ClockMock struct{ mock.Mock }
+
+// NewClockMock creates a new ClockMock.
+func NewClockMock(tb testing.TB) *ClockMock {
+	tb.Helper()
+
+	m := &ClockMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+// Func returns a store.Clock calling the mock.
+func (_m *ClockMock) Func() store.Clock {
+	return _m.Execute
+}
+
+func (_m *ClockMock) Execute() int64 {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() int64); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(int64)
+
+	return _ra0
+}
+
+func (_m *ClockMock) OnExecute() *ClockExecuteCall {
+	return &ClockExecuteCall{Call: _m.Mock.On("Execute"), Parent: _m}
+}
+
+func (_m *ClockMock) OnExecuteRaw() *ClockExecuteCall {
+	return &ClockExecuteCall{Call: _m.Mock.On("Execute"), Parent: _m}
+}
+
+type ClockExecuteCall struct {
+	*mock.Call
+	Parent *ClockMock
+}
+
+func (_c *ClockExecuteCall) Panic(msg string) *ClockExecuteCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *ClockExecuteCall) Once() *ClockExecuteCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *ClockExecuteCall) Twice() *ClockExecuteCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *ClockExecuteCall) Times(i int) *ClockExecuteCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *ClockExecuteCall) WaitUntil(w <-chan time.Time) *ClockExecuteCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *ClockExecuteCall) After(d time.Duration) *ClockExecuteCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *ClockExecuteCall) Run(fn func(args mock.Arguments)) *ClockExecuteCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *ClockExecuteCall) Maybe() *ClockExecuteCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *ClockExecuteCall) TypedReturns(a int64) *ClockExecuteCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *ClockExecuteCall) ReturnsFn(fn func() int64) *ClockExecuteCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *ClockExecuteCall) TypedRun(fn func()) *ClockExecuteCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *ClockExecuteCall) OnExecute() *ClockExecuteCall {
+	return _c.Parent.OnExecute()
+}
+
+func (_c *ClockExecuteCall) OnExecuteRaw() *ClockExecuteCall {
+	return _c.Parent.OnExecuteRaw()
+}
@@ -0,0 +1,118 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+//go:build mocks
+
+package tagged
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// notifierMock mock of Notifier.
+type notifierMock struct{ mock.Mock }
+
+// NewNotifierMock creates a new notifierMock.
+func NewNotifierMock(tb testing.TB) *notifierMock {
+	tb.Helper()
+
+	m := &notifierMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *notifierMock) Notify(msg string) error {
+	_ret := _m.Called(msg)
+
+	if _rf, ok := _ret.Get(0).(func(string) error); ok {
+		return _rf(msg)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *notifierMock) OnNotify(msg string) *notifierNotifyCall {
+	return &notifierNotifyCall{Call: _m.Mock.On("Notify", msg), Parent: _m}
+}
+
+func (_m *notifierMock) OnNotifyRaw(msg interface{}) *notifierNotifyCall {
+	return &notifierNotifyCall{Call: _m.Mock.On("Notify", msg), Parent: _m}
+}
+
+type notifierNotifyCall struct {
+	*mock.Call
+	Parent *notifierMock
+}
+
+func (_c *notifierNotifyCall) Panic(msg string) *notifierNotifyCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *notifierNotifyCall) Once() *notifierNotifyCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *notifierNotifyCall) Twice() *notifierNotifyCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *notifierNotifyCall) Times(i int) *notifierNotifyCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *notifierNotifyCall) WaitUntil(w <-chan time.Time) *notifierNotifyCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *notifierNotifyCall) After(d time.Duration) *notifierNotifyCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *notifierNotifyCall) Run(fn func(args mock.Arguments)) *notifierNotifyCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *notifierNotifyCall) Maybe() *notifierNotifyCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *notifierNotifyCall) TypedReturns(a error) *notifierNotifyCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *notifierNotifyCall) ReturnsFn(fn func(string) error) *notifierNotifyCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *notifierNotifyCall) TypedRun(fn func(string)) *notifierNotifyCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_msg := args.String(0)
+		fn(_msg)
+	})
+	return _c
+}
+
+func (_c *notifierNotifyCall) OnNotify(msg string) *notifierNotifyCall {
+	return _c.Parent.OnNotify(msg)
+}
+
+func (_c *notifierNotifyCall) OnNotifyRaw(msg interface{}) *notifierNotifyCall {
+	return _c.Parent.OnNotifyRaw(msg)
+}
@@ -0,0 +1,17 @@
+package tagged_test
+
+import (
+	"testing"
+
+	"tagged"
+)
+
+// mocktail:Notifier
+
+func TestNotifier(t *testing.T) {
+	var notifier tagged.Notifier = tagged.NewNotifierMock(t).
+		OnNotify("hello").TypedReturns(nil).Once().
+		Parent
+
+	_ = notifier.Notify("hello")
+}
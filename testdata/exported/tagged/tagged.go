@@ -0,0 +1,5 @@
+package tagged
+
+type Notifier interface {
+	Notify(msg string) error
+}
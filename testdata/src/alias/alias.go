@@ -0,0 +1,26 @@
+package alias
+
+import "alias/model"
+
+type User struct{ Name string }
+
+type Person = User
+
+type Set[T comparable] = map[T]struct{}
+
+type Directory interface {
+	Lookup(id model.ID, at model.Timestamp) (Person, error)
+	Members(group string) Set[model.ID]
+	Tag(id model.ID, tags model.Tags[string]) model.Index[model.ID, any]
+}
+
+type Repository[T any] interface {
+	Get(id model.ID) (T, error)
+	All() Set[model.ID]
+}
+
+type PersonRepository = Repository[Person]
+
+type Store[T any] = Repository[T]
+
+type Callback = model.Handler
@@ -0,0 +1,887 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package alias
+
+import (
+	"alias/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// directoryMock mock of Directory.
+type directoryMock struct{ mock.Mock }
+
+// newDirectoryMock creates a new directoryMock.
+func newDirectoryMock(tb testing.TB) *directoryMock {
+	tb.Helper()
+
+	m := &directoryMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *directoryMock) Lookup(id model.ID, at model.Timestamp) (Person, error) {
+	_ret := _m.Called(id, at)
+
+	if _rf, ok := _ret.Get(0).(func(model.ID, model.Timestamp) (Person, error)); ok {
+		return _rf(id, at)
+	}
+
+	_ra0, _ := _ret.Get(0).(Person)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *directoryMock) OnLookup(id model.ID, at model.Timestamp) *directoryLookupCall {
+	return &directoryLookupCall{Call: _m.Mock.On("Lookup", id, at), Parent: _m}
+}
+
+func (_m *directoryMock) OnLookupRaw(id interface{}, at interface{}) *directoryLookupCall {
+	return &directoryLookupCall{Call: _m.Mock.On("Lookup", id, at), Parent: _m}
+}
+
+type directoryLookupCall struct {
+	*mock.Call
+	Parent *directoryMock
+}
+
+func (_c *directoryLookupCall) Panic(msg string) *directoryLookupCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *directoryLookupCall) Once() *directoryLookupCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *directoryLookupCall) Twice() *directoryLookupCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *directoryLookupCall) Times(i int) *directoryLookupCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *directoryLookupCall) WaitUntil(w <-chan time.Time) *directoryLookupCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *directoryLookupCall) After(d time.Duration) *directoryLookupCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *directoryLookupCall) Run(fn func(args mock.Arguments)) *directoryLookupCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *directoryLookupCall) Maybe() *directoryLookupCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *directoryLookupCall) TypedReturns(a Person, b error) *directoryLookupCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *directoryLookupCall) ReturnsFn(fn func(model.ID, model.Timestamp) (Person, error)) *directoryLookupCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *directoryLookupCall) TypedRun(fn func(model.ID, model.Timestamp)) *directoryLookupCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_id, _ := args.Get(0).(model.ID)
+		_at, _ := args.Get(1).(model.Timestamp)
+		fn(_id, _at)
+	})
+	return _c
+}
+
+func (_c *directoryLookupCall) OnLookup(id model.ID, at model.Timestamp) *directoryLookupCall {
+	return _c.Parent.OnLookup(id, at)
+}
+
+func (_c *directoryLookupCall) OnMembers(group string) *directoryMembersCall {
+	return _c.Parent.OnMembers(group)
+}
+
+func (_c *directoryLookupCall) OnTag(id model.ID, tags model.Tags[string]) *directoryTagCall {
+	return _c.Parent.OnTag(id, tags)
+}
+
+func (_c *directoryLookupCall) OnLookupRaw(id interface{}, at interface{}) *directoryLookupCall {
+	return _c.Parent.OnLookupRaw(id, at)
+}
+
+func (_c *directoryLookupCall) OnMembersRaw(group interface{}) *directoryMembersCall {
+	return _c.Parent.OnMembersRaw(group)
+}
+
+func (_c *directoryLookupCall) OnTagRaw(id interface{}, tags interface{}) *directoryTagCall {
+	return _c.Parent.OnTagRaw(id, tags)
+}
+
+func (_m *directoryMock) Members(group string) Set[model.ID] {
+	_ret := _m.Called(group)
+
+	if _rf, ok := _ret.Get(0).(func(string) Set[model.ID]); ok {
+		return _rf(group)
+	}
+
+	_ra0, _ := _ret.Get(0).(Set[model.ID])
+
+	return _ra0
+}
+
+func (_m *directoryMock) OnMembers(group string) *directoryMembersCall {
+	return &directoryMembersCall{Call: _m.Mock.On("Members", group), Parent: _m}
+}
+
+func (_m *directoryMock) OnMembersRaw(group interface{}) *directoryMembersCall {
+	return &directoryMembersCall{Call: _m.Mock.On("Members", group), Parent: _m}
+}
+
+type directoryMembersCall struct {
+	*mock.Call
+	Parent *directoryMock
+}
+
+func (_c *directoryMembersCall) Panic(msg string) *directoryMembersCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *directoryMembersCall) Once() *directoryMembersCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *directoryMembersCall) Twice() *directoryMembersCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *directoryMembersCall) Times(i int) *directoryMembersCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *directoryMembersCall) WaitUntil(w <-chan time.Time) *directoryMembersCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *directoryMembersCall) After(d time.Duration) *directoryMembersCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *directoryMembersCall) Run(fn func(args mock.Arguments)) *directoryMembersCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *directoryMembersCall) Maybe() *directoryMembersCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *directoryMembersCall) TypedReturns(a Set[model.ID]) *directoryMembersCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *directoryMembersCall) ReturnsFn(fn func(string) Set[model.ID]) *directoryMembersCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *directoryMembersCall) TypedRun(fn func(string)) *directoryMembersCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_group := args.String(0)
+		fn(_group)
+	})
+	return _c
+}
+
+func (_c *directoryMembersCall) OnLookup(id model.ID, at model.Timestamp) *directoryLookupCall {
+	return _c.Parent.OnLookup(id, at)
+}
+
+func (_c *directoryMembersCall) OnMembers(group string) *directoryMembersCall {
+	return _c.Parent.OnMembers(group)
+}
+
+func (_c *directoryMembersCall) OnTag(id model.ID, tags model.Tags[string]) *directoryTagCall {
+	return _c.Parent.OnTag(id, tags)
+}
+
+func (_c *directoryMembersCall) OnLookupRaw(id interface{}, at interface{}) *directoryLookupCall {
+	return _c.Parent.OnLookupRaw(id, at)
+}
+
+func (_c *directoryMembersCall) OnMembersRaw(group interface{}) *directoryMembersCall {
+	return _c.Parent.OnMembersRaw(group)
+}
+
+func (_c *directoryMembersCall) OnTagRaw(id interface{}, tags interface{}) *directoryTagCall {
+	return _c.Parent.OnTagRaw(id, tags)
+}
+
+func (_m *directoryMock) Tag(id model.ID, tags model.Tags[string]) model.Index[model.ID, any] {
+	_ret := _m.Called(id, tags)
+
+	if _rf, ok := _ret.Get(0).(func(model.ID, model.Tags[string]) model.Index[model.ID, any]); ok {
+		return _rf(id, tags)
+	}
+
+	_ra0, _ := _ret.Get(0).(model.Index[model.ID, any])
+
+	return _ra0
+}
+
+func (_m *directoryMock) OnTag(id model.ID, tags model.Tags[string]) *directoryTagCall {
+	return &directoryTagCall{Call: _m.Mock.On("Tag", id, tags), Parent: _m}
+}
+
+func (_m *directoryMock) OnTagRaw(id interface{}, tags interface{}) *directoryTagCall {
+	return &directoryTagCall{Call: _m.Mock.On("Tag", id, tags), Parent: _m}
+}
+
+type directoryTagCall struct {
+	*mock.Call
+	Parent *directoryMock
+}
+
+func (_c *directoryTagCall) Panic(msg string) *directoryTagCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *directoryTagCall) Once() *directoryTagCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *directoryTagCall) Twice() *directoryTagCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *directoryTagCall) Times(i int) *directoryTagCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *directoryTagCall) WaitUntil(w <-chan time.Time) *directoryTagCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *directoryTagCall) After(d time.Duration) *directoryTagCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *directoryTagCall) Run(fn func(args mock.Arguments)) *directoryTagCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *directoryTagCall) Maybe() *directoryTagCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *directoryTagCall) TypedReturns(a model.Index[model.ID, any]) *directoryTagCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *directoryTagCall) ReturnsFn(fn func(model.ID, model.Tags[string]) model.Index[model.ID, any]) *directoryTagCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *directoryTagCall) TypedRun(fn func(model.ID, model.Tags[string])) *directoryTagCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_id, _ := args.Get(0).(model.ID)
+		_tags, _ := args.Get(1).(model.Tags[string])
+		fn(_id, _tags)
+	})
+	return _c
+}
+
+func (_c *directoryTagCall) OnLookup(id model.ID, at model.Timestamp) *directoryLookupCall {
+	return _c.Parent.OnLookup(id, at)
+}
+
+func (_c *directoryTagCall) OnMembers(group string) *directoryMembersCall {
+	return _c.Parent.OnMembers(group)
+}
+
+func (_c *directoryTagCall) OnTag(id model.ID, tags model.Tags[string]) *directoryTagCall {
+	return _c.Parent.OnTag(id, tags)
+}
+
+func (_c *directoryTagCall) OnLookupRaw(id interface{}, at interface{}) *directoryLookupCall {
+	return _c.Parent.OnLookupRaw(id, at)
+}
+
+func (_c *directoryTagCall) OnMembersRaw(group interface{}) *directoryMembersCall {
+	return _c.Parent.OnMembersRaw(group)
+}
+
+func (_c *directoryTagCall) OnTagRaw(id interface{}, tags interface{}) *directoryTagCall {
+	return _c.Parent.OnTagRaw(id, tags)
+}
+
+// personRepositoryMock mock of PersonRepository.
+type personRepositoryMock struct{ mock.Mock }
+
+// newPersonRepositoryMock creates a new personRepositoryMock.
+func newPersonRepositoryMock(tb testing.TB) *personRepositoryMock {
+	tb.Helper()
+
+	m := &personRepositoryMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *personRepositoryMock) All() Set[model.ID] {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() Set[model.ID]); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(Set[model.ID])
+
+	return _ra0
+}
+
+func (_m *personRepositoryMock) OnAll() *personRepositoryAllCall {
+	return &personRepositoryAllCall{Call: _m.Mock.On("All"), Parent: _m}
+}
+
+func (_m *personRepositoryMock) OnAllRaw() *personRepositoryAllCall {
+	return &personRepositoryAllCall{Call: _m.Mock.On("All"), Parent: _m}
+}
+
+type personRepositoryAllCall struct {
+	*mock.Call
+	Parent *personRepositoryMock
+}
+
+func (_c *personRepositoryAllCall) Panic(msg string) *personRepositoryAllCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *personRepositoryAllCall) Once() *personRepositoryAllCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *personRepositoryAllCall) Twice() *personRepositoryAllCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *personRepositoryAllCall) Times(i int) *personRepositoryAllCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *personRepositoryAllCall) WaitUntil(w <-chan time.Time) *personRepositoryAllCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *personRepositoryAllCall) After(d time.Duration) *personRepositoryAllCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *personRepositoryAllCall) Run(fn func(args mock.Arguments)) *personRepositoryAllCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *personRepositoryAllCall) Maybe() *personRepositoryAllCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *personRepositoryAllCall) TypedReturns(a Set[model.ID]) *personRepositoryAllCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *personRepositoryAllCall) ReturnsFn(fn func() Set[model.ID]) *personRepositoryAllCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *personRepositoryAllCall) TypedRun(fn func()) *personRepositoryAllCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *personRepositoryAllCall) OnAll() *personRepositoryAllCall {
+	return _c.Parent.OnAll()
+}
+
+func (_c *personRepositoryAllCall) OnGet(id model.ID) *personRepositoryGetCall {
+	return _c.Parent.OnGet(id)
+}
+
+func (_c *personRepositoryAllCall) OnAllRaw() *personRepositoryAllCall {
+	return _c.Parent.OnAllRaw()
+}
+
+func (_c *personRepositoryAllCall) OnGetRaw(id interface{}) *personRepositoryGetCall {
+	return _c.Parent.OnGetRaw(id)
+}
+
+func (_m *personRepositoryMock) Get(id model.ID) (Person, error) {
+	_ret := _m.Called(id)
+
+	if _rf, ok := _ret.Get(0).(func(model.ID) (Person, error)); ok {
+		return _rf(id)
+	}
+
+	_ra0, _ := _ret.Get(0).(Person)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *personRepositoryMock) OnGet(id model.ID) *personRepositoryGetCall {
+	return &personRepositoryGetCall{Call: _m.Mock.On("Get", id), Parent: _m}
+}
+
+func (_m *personRepositoryMock) OnGetRaw(id interface{}) *personRepositoryGetCall {
+	return &personRepositoryGetCall{Call: _m.Mock.On("Get", id), Parent: _m}
+}
+
+type personRepositoryGetCall struct {
+	*mock.Call
+	Parent *personRepositoryMock
+}
+
+func (_c *personRepositoryGetCall) Panic(msg string) *personRepositoryGetCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *personRepositoryGetCall) Once() *personRepositoryGetCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *personRepositoryGetCall) Twice() *personRepositoryGetCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *personRepositoryGetCall) Times(i int) *personRepositoryGetCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *personRepositoryGetCall) WaitUntil(w <-chan time.Time) *personRepositoryGetCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *personRepositoryGetCall) After(d time.Duration) *personRepositoryGetCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *personRepositoryGetCall) Run(fn func(args mock.Arguments)) *personRepositoryGetCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *personRepositoryGetCall) Maybe() *personRepositoryGetCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *personRepositoryGetCall) TypedReturns(a Person, b error) *personRepositoryGetCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *personRepositoryGetCall) ReturnsFn(fn func(model.ID) (Person, error)) *personRepositoryGetCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *personRepositoryGetCall) TypedRun(fn func(model.ID)) *personRepositoryGetCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_id, _ := args.Get(0).(model.ID)
+		fn(_id)
+	})
+	return _c
+}
+
+func (_c *personRepositoryGetCall) OnAll() *personRepositoryAllCall {
+	return _c.Parent.OnAll()
+}
+
+func (_c *personRepositoryGetCall) OnGet(id model.ID) *personRepositoryGetCall {
+	return _c.Parent.OnGet(id)
+}
+
+func (_c *personRepositoryGetCall) OnAllRaw() *personRepositoryAllCall {
+	return _c.Parent.OnAllRaw()
+}
+
+func (_c *personRepositoryGetCall) OnGetRaw(id interface{}) *personRepositoryGetCall {
+	return _c.Parent.OnGetRaw(id)
+}
+
+// userStoreMock mock of UserStore.
+type userStoreMock struct{ mock.Mock }
+
+// newUserStoreMock creates a new userStoreMock.
+func newUserStoreMock(tb testing.TB) *userStoreMock {
+	tb.Helper()
+
+	m := &userStoreMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *userStoreMock) All() Set[model.ID] {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() Set[model.ID]); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(Set[model.ID])
+
+	return _ra0
+}
+
+func (_m *userStoreMock) OnAll() *userStoreAllCall {
+	return &userStoreAllCall{Call: _m.Mock.On("All"), Parent: _m}
+}
+
+func (_m *userStoreMock) OnAllRaw() *userStoreAllCall {
+	return &userStoreAllCall{Call: _m.Mock.On("All"), Parent: _m}
+}
+
+type userStoreAllCall struct {
+	*mock.Call
+	Parent *userStoreMock
+}
+
+func (_c *userStoreAllCall) Panic(msg string) *userStoreAllCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *userStoreAllCall) Once() *userStoreAllCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *userStoreAllCall) Twice() *userStoreAllCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *userStoreAllCall) Times(i int) *userStoreAllCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *userStoreAllCall) WaitUntil(w <-chan time.Time) *userStoreAllCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *userStoreAllCall) After(d time.Duration) *userStoreAllCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *userStoreAllCall) Run(fn func(args mock.Arguments)) *userStoreAllCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *userStoreAllCall) Maybe() *userStoreAllCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *userStoreAllCall) TypedReturns(a Set[model.ID]) *userStoreAllCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *userStoreAllCall) ReturnsFn(fn func() Set[model.ID]) *userStoreAllCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *userStoreAllCall) TypedRun(fn func()) *userStoreAllCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *userStoreAllCall) OnAll() *userStoreAllCall {
+	return _c.Parent.OnAll()
+}
+
+func (_c *userStoreAllCall) OnGet(id model.ID) *userStoreGetCall {
+	return _c.Parent.OnGet(id)
+}
+
+func (_c *userStoreAllCall) OnAllRaw() *userStoreAllCall {
+	return _c.Parent.OnAllRaw()
+}
+
+func (_c *userStoreAllCall) OnGetRaw(id interface{}) *userStoreGetCall {
+	return _c.Parent.OnGetRaw(id)
+}
+
+func (_m *userStoreMock) Get(id model.ID) (User, error) {
+	_ret := _m.Called(id)
+
+	if _rf, ok := _ret.Get(0).(func(model.ID) (User, error)); ok {
+		return _rf(id)
+	}
+
+	_ra0, _ := _ret.Get(0).(User)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *userStoreMock) OnGet(id model.ID) *userStoreGetCall {
+	return &userStoreGetCall{Call: _m.Mock.On("Get", id), Parent: _m}
+}
+
+func (_m *userStoreMock) OnGetRaw(id interface{}) *userStoreGetCall {
+	return &userStoreGetCall{Call: _m.Mock.On("Get", id), Parent: _m}
+}
+
+type userStoreGetCall struct {
+	*mock.Call
+	Parent *userStoreMock
+}
+
+func (_c *userStoreGetCall) Panic(msg string) *userStoreGetCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *userStoreGetCall) Once() *userStoreGetCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *userStoreGetCall) Twice() *userStoreGetCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *userStoreGetCall) Times(i int) *userStoreGetCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *userStoreGetCall) WaitUntil(w <-chan time.Time) *userStoreGetCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *userStoreGetCall) After(d time.Duration) *userStoreGetCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *userStoreGetCall) Run(fn func(args mock.Arguments)) *userStoreGetCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *userStoreGetCall) Maybe() *userStoreGetCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *userStoreGetCall) TypedReturns(a User, b error) *userStoreGetCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *userStoreGetCall) ReturnsFn(fn func(model.ID) (User, error)) *userStoreGetCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *userStoreGetCall) TypedRun(fn func(model.ID)) *userStoreGetCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_id, _ := args.Get(0).(model.ID)
+		fn(_id)
+	})
+	return _c
+}
+
+func (_c *userStoreGetCall) OnAll() *userStoreAllCall {
+	return _c.Parent.OnAll()
+}
+
+func (_c *userStoreGetCall) OnGet(id model.ID) *userStoreGetCall {
+	return _c.Parent.OnGet(id)
+}
+
+func (_c *userStoreGetCall) OnAllRaw() *userStoreAllCall {
+	return _c.Parent.OnAllRaw()
+}
+
+func (_c *userStoreGetCall) OnGetRaw(id interface{}) *userStoreGetCall {
+	return _c.Parent.OnGetRaw(id)
+}
+
+// callbackMock mock of Callback.
+type callbackMock struct{ mock.Mock }
+
+// newCallbackMock creates a new callbackMock.
+func newCallbackMock(tb testing.TB) *callbackMock {
+	tb.Helper()
+
+	m := &callbackMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+// Func returns a model.Handler calling the mock.
+func (_m *callbackMock) Func() model.Handler {
+	return _m.Execute
+}
+
+func (_m *callbackMock) Execute(id model.ID) error {
+	_ret := _m.Called(id)
+
+	if _rf, ok := _ret.Get(0).(func(model.ID) error); ok {
+		return _rf(id)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *callbackMock) OnExecute(id model.ID) *callbackExecuteCall {
+	return &callbackExecuteCall{Call: _m.Mock.On("Execute", id), Parent: _m}
+}
+
+func (_m *callbackMock) OnExecuteRaw(id interface{}) *callbackExecuteCall {
+	return &callbackExecuteCall{Call: _m.Mock.On("Execute", id), Parent: _m}
+}
+
+type callbackExecuteCall struct {
+	*mock.Call
+	Parent *callbackMock
+}
+
+func (_c *callbackExecuteCall) Panic(msg string) *callbackExecuteCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *callbackExecuteCall) Once() *callbackExecuteCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *callbackExecuteCall) Twice() *callbackExecuteCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *callbackExecuteCall) Times(i int) *callbackExecuteCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *callbackExecuteCall) WaitUntil(w <-chan time.Time) *callbackExecuteCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *callbackExecuteCall) After(d time.Duration) *callbackExecuteCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *callbackExecuteCall) Run(fn func(args mock.Arguments)) *callbackExecuteCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *callbackExecuteCall) Maybe() *callbackExecuteCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *callbackExecuteCall) TypedReturns(a error) *callbackExecuteCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *callbackExecuteCall) ReturnsFn(fn func(model.ID) error) *callbackExecuteCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *callbackExecuteCall) TypedRun(fn func(model.ID)) *callbackExecuteCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_id, _ := args.Get(0).(model.ID)
+		fn(_id)
+	})
+	return _c
+}
+
+func (_c *callbackExecuteCall) OnExecute(id model.ID) *callbackExecuteCall {
+	return _c.Parent.OnExecute(id)
+}
+
+func (_c *callbackExecuteCall) OnExecuteRaw(id interface{}) *callbackExecuteCall {
+	return _c.Parent.OnExecuteRaw(id)
+}
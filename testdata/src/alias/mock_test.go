@@ -0,0 +1,51 @@
+package alias
+
+import (
+	"testing"
+	"time"
+
+	"alias/model"
+)
+
+// mocktail:Directory
+// mocktail:PersonRepository
+// mocktail:Store[User] name=userStoreMock
+// mocktail:Callback
+
+func TestDirectory(t *testing.T) {
+	now := time.Now()
+
+	var directory Directory = newDirectoryMock(t).
+		OnLookup("bob", now).TypedReturns(Person{Name: "bob"}, nil).Once().
+		Parent.
+		OnMembers("admin").TypedReturns(Set[model.ID]{"bob": {}}).Once().
+		Parent.
+		OnTag("bob", model.Tags[string]{"a"}).TypedReturns(model.Index[model.ID, any]{}).Once().
+		Parent
+
+	_, _ = directory.Lookup("bob", now)
+	_ = directory.Members("admin")
+	_ = directory.Tag("bob", model.Tags[string]{"a"})
+}
+
+func TestRepository(t *testing.T) {
+	var repo PersonRepository = newPersonRepositoryMock(t).
+		OnGet("bob").TypedReturns(Person{Name: "bob"}, nil).Once().
+		Parent
+
+	_, _ = repo.Get("bob")
+
+	var store Store[User] = newUserStoreMock(t).
+		OnAll().TypedReturns(Set[model.ID]{}).Once().
+		Parent
+
+	_ = store.All()
+}
+
+func TestCallback(t *testing.T) {
+	var callback Callback = newCallbackMock(t).
+		OnExecute("bob").TypedReturns(nil).Once().
+		Parent.Func()
+
+	_ = callback("bob")
+}
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+type ID = string
+
+type Timestamp = time.Time
+
+type Tags[T any] = []T
+
+type Index[K comparable, V any] = map[K]V
+
+type Handler func(id ID) error
@@ -2921,3 +2921,109 @@ func (_c *coconutZooCall) OnYooRaw(st interface{}) *coconutYooCall {
 func (_c *coconutZooCall) OnZooRaw(st interface{}) *coconutZooCall {
 	return _c.Parent.OnZooRaw(st)
 }
+
+// strawberryMock mock of Strawberry.
+type strawberryMock struct{ mock.Mock }
+
+// newStrawberryMock creates a new strawberryMock.
+func newStrawberryMock(tb testing.TB) *strawberryMock {
+	tb.Helper()
+
+	m := &strawberryMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *strawberryMock) Bar(aParam string) int {
+	_ret := _m.Called(aParam)
+
+	if _rf, ok := _ret.Get(0).(func(string) int); ok {
+		return _rf(aParam)
+	}
+
+	_ra0 := _ret.Int(0)
+
+	return _ra0
+}
+
+func (_m *strawberryMock) OnBar(aParam string) *strawberryBarCall {
+	return &strawberryBarCall{Call: _m.Mock.On("Bar", aParam), Parent: _m}
+}
+
+func (_m *strawberryMock) OnBarRaw(aParam interface{}) *strawberryBarCall {
+	return &strawberryBarCall{Call: _m.Mock.On("Bar", aParam), Parent: _m}
+}
+
+type strawberryBarCall struct {
+	*mock.Call
+	Parent *strawberryMock
+}
+
+func (_c *strawberryBarCall) Panic(msg string) *strawberryBarCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *strawberryBarCall) Once() *strawberryBarCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *strawberryBarCall) Twice() *strawberryBarCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *strawberryBarCall) Times(i int) *strawberryBarCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *strawberryBarCall) WaitUntil(w <-chan time.Time) *strawberryBarCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *strawberryBarCall) After(d time.Duration) *strawberryBarCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *strawberryBarCall) Run(fn func(args mock.Arguments)) *strawberryBarCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *strawberryBarCall) Maybe() *strawberryBarCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *strawberryBarCall) TypedReturns(a int) *strawberryBarCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *strawberryBarCall) ReturnsFn(fn func(string) int) *strawberryBarCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *strawberryBarCall) TypedRun(fn func(string)) *strawberryBarCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_aParam := args.String(0)
+		fn(_aParam)
+	})
+	return _c
+}
+
+func (_c *strawberryBarCall) OnBar(aParam string) *strawberryBarCall {
+	return _c.Parent.OnBar(aParam)
+}
+
+func (_c *strawberryBarCall) OnBarRaw(aParam interface{}) *strawberryBarCall {
+	return _c.Parent.OnBarRaw(aParam)
+}
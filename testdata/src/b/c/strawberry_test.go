@@ -0,0 +1,16 @@
+package c
+
+import (
+	"testing"
+)
+
+// mocktail:Strawberry
+// mocktail:Pineapple
+
+func TestStrawberry(t *testing.T) {
+	var s Strawberry = newStrawberryMock(t).
+		OnBar("a").TypedReturns(1).Once().
+		Parent
+
+	s.Bar("a")
+}
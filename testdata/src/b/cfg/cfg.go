@@ -0,0 +1,5 @@
+package cfg
+
+type Greeter interface {
+	Greet(name string) string
+}
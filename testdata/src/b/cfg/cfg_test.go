@@ -0,0 +1,20 @@
+package cfg
+
+import (
+	"io"
+	"testing"
+)
+
+func TestGreeter(t *testing.T) {
+	var g Greeter = newGreeterMock(t).
+		OnGreet("a").TypedReturns("hello a").Once().
+		Parent
+
+	g.Greet("a")
+
+	var c io.Closer = newCloserMock(t).
+		OnClose().TypedReturns(nil).Once().
+		Parent
+
+	_ = c.Close()
+}
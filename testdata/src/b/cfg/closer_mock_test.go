@@ -0,0 +1,115 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package cfg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// closerStub mock of Closer.
+type closerStub struct{ mock.Mock }
+
+// newCloserMock creates a new closerStub.
+func newCloserMock(tb testing.TB) *closerStub {
+	tb.Helper()
+
+	m := &closerStub{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *closerStub) Close() error {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() error); ok {
+		return _rf()
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *closerStub) OnClose() *closerStubCloseCall {
+	return &closerStubCloseCall{Call: _m.Mock.On("Close"), Parent: _m}
+}
+
+func (_m *closerStub) OnCloseRaw() *closerStubCloseCall {
+	return &closerStubCloseCall{Call: _m.Mock.On("Close"), Parent: _m}
+}
+
+type closerStubCloseCall struct {
+	*mock.Call
+	Parent *closerStub
+}
+
+func (_c *closerStubCloseCall) Panic(msg string) *closerStubCloseCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *closerStubCloseCall) Once() *closerStubCloseCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *closerStubCloseCall) Twice() *closerStubCloseCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *closerStubCloseCall) Times(i int) *closerStubCloseCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *closerStubCloseCall) WaitUntil(w <-chan time.Time) *closerStubCloseCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *closerStubCloseCall) After(d time.Duration) *closerStubCloseCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *closerStubCloseCall) Run(fn func(args mock.Arguments)) *closerStubCloseCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *closerStubCloseCall) Maybe() *closerStubCloseCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *closerStubCloseCall) TypedReturns(a error) *closerStubCloseCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *closerStubCloseCall) ReturnsFn(fn func() error) *closerStubCloseCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *closerStubCloseCall) TypedRun(fn func()) *closerStubCloseCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *closerStubCloseCall) OnClose() *closerStubCloseCall {
+	return _c.Parent.OnClose()
+}
+
+func (_c *closerStubCloseCall) OnCloseRaw() *closerStubCloseCall {
+	return _c.Parent.OnCloseRaw()
+}
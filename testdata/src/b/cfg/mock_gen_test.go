@@ -0,0 +1,116 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package cfg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// greeterMock mock of Greeter.
+type greeterMock struct{ mock.Mock }
+
+// newGreeterMock creates a new greeterMock.
+func newGreeterMock(tb testing.TB) *greeterMock {
+	tb.Helper()
+
+	m := &greeterMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *greeterMock) Greet(name string) string {
+	_ret := _m.Called(name)
+
+	if _rf, ok := _ret.Get(0).(func(string) string); ok {
+		return _rf(name)
+	}
+
+	_ra0 := _ret.String(0)
+
+	return _ra0
+}
+
+func (_m *greeterMock) OnGreet(name string) *greeterGreetCall {
+	return &greeterGreetCall{Call: _m.Mock.On("Greet", name), Parent: _m}
+}
+
+func (_m *greeterMock) OnGreetRaw(name interface{}) *greeterGreetCall {
+	return &greeterGreetCall{Call: _m.Mock.On("Greet", name), Parent: _m}
+}
+
+type greeterGreetCall struct {
+	*mock.Call
+	Parent *greeterMock
+}
+
+func (_c *greeterGreetCall) Panic(msg string) *greeterGreetCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *greeterGreetCall) Once() *greeterGreetCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *greeterGreetCall) Twice() *greeterGreetCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *greeterGreetCall) Times(i int) *greeterGreetCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *greeterGreetCall) WaitUntil(w <-chan time.Time) *greeterGreetCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *greeterGreetCall) After(d time.Duration) *greeterGreetCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *greeterGreetCall) Run(fn func(args mock.Arguments)) *greeterGreetCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *greeterGreetCall) Maybe() *greeterGreetCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *greeterGreetCall) TypedReturns(a string) *greeterGreetCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *greeterGreetCall) ReturnsFn(fn func(string) string) *greeterGreetCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *greeterGreetCall) TypedRun(fn func(string)) *greeterGreetCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_name := args.String(0)
+		fn(_name)
+	})
+	return _c
+}
+
+func (_c *greeterGreetCall) OnGreet(name string) *greeterGreetCall {
+	return _c.Parent.OnGreet(name)
+}
+
+func (_c *greeterGreetCall) OnGreetRaw(name interface{}) *greeterGreetCall {
+	return _c.Parent.OnGreetRaw(name)
+}
@@ -0,0 +1,14 @@
+package collision
+
+import (
+	"b/collision/e/v2"
+	"b/collision/mock"
+	"b/collision/user"
+	xlog "b/collision/x/log"
+	ylog "b/collision/y/log"
+)
+
+type Logger interface {
+	Write(entry xlog.Entry, level ylog.Level) (e.Event, error)
+	Tag(user user.User, value mock.Value) user.User
+}
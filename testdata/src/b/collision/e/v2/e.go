@@ -0,0 +1,3 @@
+package e
+
+type Event struct{}
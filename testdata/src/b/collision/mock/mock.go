@@ -0,0 +1,3 @@
+package mock
+
+type Value string
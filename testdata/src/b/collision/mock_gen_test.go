@@ -0,0 +1,231 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package collision
+
+import (
+	"b/collision/e/v2"
+	mock2 "b/collision/mock"
+	user2 "b/collision/user"
+	"b/collision/x/log"
+	log2 "b/collision/y/log"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// loggerMock mock of Logger.
+type loggerMock struct{ mock.Mock }
+
+// newLoggerMock creates a new loggerMock.
+func newLoggerMock(tb testing.TB) *loggerMock {
+	tb.Helper()
+
+	m := &loggerMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *loggerMock) Tag(user user2.User, value mock2.Value) user2.User {
+	_ret := _m.Called(user, value)
+
+	if _rf, ok := _ret.Get(0).(func(user2.User, mock2.Value) user2.User); ok {
+		return _rf(user, value)
+	}
+
+	_ra0, _ := _ret.Get(0).(user2.User)
+
+	return _ra0
+}
+
+func (_m *loggerMock) OnTag(user user2.User, value mock2.Value) *loggerTagCall {
+	return &loggerTagCall{Call: _m.Mock.On("Tag", user, value), Parent: _m}
+}
+
+func (_m *loggerMock) OnTagRaw(user interface{}, value interface{}) *loggerTagCall {
+	return &loggerTagCall{Call: _m.Mock.On("Tag", user, value), Parent: _m}
+}
+
+type loggerTagCall struct {
+	*mock.Call
+	Parent *loggerMock
+}
+
+func (_c *loggerTagCall) Panic(msg string) *loggerTagCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *loggerTagCall) Once() *loggerTagCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *loggerTagCall) Twice() *loggerTagCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *loggerTagCall) Times(i int) *loggerTagCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *loggerTagCall) WaitUntil(w <-chan time.Time) *loggerTagCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *loggerTagCall) After(d time.Duration) *loggerTagCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *loggerTagCall) Run(fn func(args mock.Arguments)) *loggerTagCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *loggerTagCall) Maybe() *loggerTagCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *loggerTagCall) TypedReturns(a user2.User) *loggerTagCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *loggerTagCall) ReturnsFn(fn func(user2.User, mock2.Value) user2.User) *loggerTagCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *loggerTagCall) TypedRun(fn func(user2.User, mock2.Value)) *loggerTagCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_user, _ := args.Get(0).(user2.User)
+		_value, _ := args.Get(1).(mock2.Value)
+		fn(_user, _value)
+	})
+	return _c
+}
+
+func (_c *loggerTagCall) OnTag(user user2.User, value mock2.Value) *loggerTagCall {
+	return _c.Parent.OnTag(user, value)
+}
+
+func (_c *loggerTagCall) OnWrite(entry log.Entry, level log2.Level) *loggerWriteCall {
+	return _c.Parent.OnWrite(entry, level)
+}
+
+func (_c *loggerTagCall) OnTagRaw(user interface{}, value interface{}) *loggerTagCall {
+	return _c.Parent.OnTagRaw(user, value)
+}
+
+func (_c *loggerTagCall) OnWriteRaw(entry interface{}, level interface{}) *loggerWriteCall {
+	return _c.Parent.OnWriteRaw(entry, level)
+}
+
+func (_m *loggerMock) Write(entry log.Entry, level log2.Level) (e.Event, error) {
+	_ret := _m.Called(entry, level)
+
+	if _rf, ok := _ret.Get(0).(func(log.Entry, log2.Level) (e.Event, error)); ok {
+		return _rf(entry, level)
+	}
+
+	_ra0, _ := _ret.Get(0).(e.Event)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *loggerMock) OnWrite(entry log.Entry, level log2.Level) *loggerWriteCall {
+	return &loggerWriteCall{Call: _m.Mock.On("Write", entry, level), Parent: _m}
+}
+
+func (_m *loggerMock) OnWriteRaw(entry interface{}, level interface{}) *loggerWriteCall {
+	return &loggerWriteCall{Call: _m.Mock.On("Write", entry, level), Parent: _m}
+}
+
+type loggerWriteCall struct {
+	*mock.Call
+	Parent *loggerMock
+}
+
+func (_c *loggerWriteCall) Panic(msg string) *loggerWriteCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *loggerWriteCall) Once() *loggerWriteCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *loggerWriteCall) Twice() *loggerWriteCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *loggerWriteCall) Times(i int) *loggerWriteCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *loggerWriteCall) WaitUntil(w <-chan time.Time) *loggerWriteCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *loggerWriteCall) After(d time.Duration) *loggerWriteCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *loggerWriteCall) Run(fn func(args mock.Arguments)) *loggerWriteCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *loggerWriteCall) Maybe() *loggerWriteCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *loggerWriteCall) TypedReturns(a e.Event, b error) *loggerWriteCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *loggerWriteCall) ReturnsFn(fn func(log.Entry, log2.Level) (e.Event, error)) *loggerWriteCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *loggerWriteCall) TypedRun(fn func(log.Entry, log2.Level)) *loggerWriteCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_entry, _ := args.Get(0).(log.Entry)
+		_level, _ := args.Get(1).(log2.Level)
+		fn(_entry, _level)
+	})
+	return _c
+}
+
+func (_c *loggerWriteCall) OnTag(user user2.User, value mock2.Value) *loggerTagCall {
+	return _c.Parent.OnTag(user, value)
+}
+
+func (_c *loggerWriteCall) OnWrite(entry log.Entry, level log2.Level) *loggerWriteCall {
+	return _c.Parent.OnWrite(entry, level)
+}
+
+func (_c *loggerWriteCall) OnTagRaw(user interface{}, value interface{}) *loggerTagCall {
+	return _c.Parent.OnTagRaw(user, value)
+}
+
+func (_c *loggerWriteCall) OnWriteRaw(entry interface{}, level interface{}) *loggerWriteCall {
+	return _c.Parent.OnWriteRaw(entry, level)
+}
@@ -0,0 +1,22 @@
+package collision
+
+import (
+	"testing"
+
+	"b/collision/e/v2"
+	"b/collision/user"
+	xlog "b/collision/x/log"
+)
+
+// mocktail:Logger
+
+func TestLogger(t *testing.T) {
+	var logger Logger = newLoggerMock(t).
+		OnWrite(xlog.Entry{Message: "hello"}, 1).TypedReturns(e.Event{}, nil).Once().
+		Parent.
+		OnTag(user.User{Name: "bob"}, "admin").TypedReturns(user.User{Name: "alice"}).Once().
+		Parent
+
+	_, _ = logger.Write(xlog.Entry{Message: "hello"}, 1)
+	_ = logger.Tag(user.User{Name: "bob"}, "admin")
+}
@@ -0,0 +1,3 @@
+package user
+
+type User struct{ Name string }
@@ -0,0 +1,3 @@
+package log
+
+type Entry struct{ Message string }
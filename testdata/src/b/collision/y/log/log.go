@@ -0,0 +1,3 @@
+package log
+
+type Level int
@@ -0,0 +1,13 @@
+package constrained
+
+import "testing"
+
+// mocktail:Closer
+
+func TestCloser(t *testing.T) {
+	var closer Closer = newCloserMock(t).
+		OnClose().TypedReturns(nil).Once().
+		Parent
+
+	_ = closer.Close()
+}
@@ -0,0 +1,13 @@
+package constrained
+
+type Sender interface {
+	Send(msg string) error
+}
+
+type Receiver interface {
+	Receive() (string, error)
+}
+
+type Closer interface {
+	Close() error
+}
@@ -0,0 +1,16 @@
+//go:build integration
+
+package constrained
+
+import "testing"
+
+// mocktail:Receiver
+// mocktail:Sender
+
+func TestReceiver(t *testing.T) {
+	var receiver Receiver = newReceiverMock(t).
+		OnReceive().TypedReturns("hello", nil).Once().
+		Parent
+
+	_, _ = receiver.Receive()
+}
@@ -0,0 +1,116 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package constrained
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// senderMock mock of Sender.
+type senderMock struct{ mock.Mock }
+
+// newSenderMock creates a new senderMock.
+func newSenderMock(tb testing.TB) *senderMock {
+	tb.Helper()
+
+	m := &senderMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *senderMock) Send(msg string) error {
+	_ret := _m.Called(msg)
+
+	if _rf, ok := _ret.Get(0).(func(string) error); ok {
+		return _rf(msg)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *senderMock) OnSend(msg string) *senderSendCall {
+	return &senderSendCall{Call: _m.Mock.On("Send", msg), Parent: _m}
+}
+
+func (_m *senderMock) OnSendRaw(msg interface{}) *senderSendCall {
+	return &senderSendCall{Call: _m.Mock.On("Send", msg), Parent: _m}
+}
+
+type senderSendCall struct {
+	*mock.Call
+	Parent *senderMock
+}
+
+func (_c *senderSendCall) Panic(msg string) *senderSendCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *senderSendCall) Once() *senderSendCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *senderSendCall) Twice() *senderSendCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *senderSendCall) Times(i int) *senderSendCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *senderSendCall) WaitUntil(w <-chan time.Time) *senderSendCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *senderSendCall) After(d time.Duration) *senderSendCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *senderSendCall) Run(fn func(args mock.Arguments)) *senderSendCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *senderSendCall) Maybe() *senderSendCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *senderSendCall) TypedReturns(a error) *senderSendCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *senderSendCall) ReturnsFn(fn func(string) error) *senderSendCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *senderSendCall) TypedRun(fn func(string)) *senderSendCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_msg := args.String(0)
+		fn(_msg)
+	})
+	return _c
+}
+
+func (_c *senderSendCall) OnSend(msg string) *senderSendCall {
+	return _c.Parent.OnSend(msg)
+}
+
+func (_c *senderSendCall) OnSendRaw(msg interface{}) *senderSendCall {
+	return _c.Parent.OnSendRaw(msg)
+}
@@ -0,0 +1,118 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+//go:build integration
+
+package constrained
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// receiverMock mock of Receiver.
+type receiverMock struct{ mock.Mock }
+
+// newReceiverMock creates a new receiverMock.
+func newReceiverMock(tb testing.TB) *receiverMock {
+	tb.Helper()
+
+	m := &receiverMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *receiverMock) Receive() (string, error) {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() (string, error)); ok {
+		return _rf()
+	}
+
+	_ra0 := _ret.String(0)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *receiverMock) OnReceive() *receiverReceiveCall {
+	return &receiverReceiveCall{Call: _m.Mock.On("Receive"), Parent: _m}
+}
+
+func (_m *receiverMock) OnReceiveRaw() *receiverReceiveCall {
+	return &receiverReceiveCall{Call: _m.Mock.On("Receive"), Parent: _m}
+}
+
+type receiverReceiveCall struct {
+	*mock.Call
+	Parent *receiverMock
+}
+
+func (_c *receiverReceiveCall) Panic(msg string) *receiverReceiveCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *receiverReceiveCall) Once() *receiverReceiveCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *receiverReceiveCall) Twice() *receiverReceiveCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *receiverReceiveCall) Times(i int) *receiverReceiveCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *receiverReceiveCall) WaitUntil(w <-chan time.Time) *receiverReceiveCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *receiverReceiveCall) After(d time.Duration) *receiverReceiveCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *receiverReceiveCall) Run(fn func(args mock.Arguments)) *receiverReceiveCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *receiverReceiveCall) Maybe() *receiverReceiveCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *receiverReceiveCall) TypedReturns(a string, b error) *receiverReceiveCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *receiverReceiveCall) ReturnsFn(fn func() (string, error)) *receiverReceiveCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *receiverReceiveCall) TypedRun(fn func()) *receiverReceiveCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *receiverReceiveCall) OnReceive() *receiverReceiveCall {
+	return _c.Parent.OnReceive()
+}
+
+func (_c *receiverReceiveCall) OnReceiveRaw() *receiverReceiveCall {
+	return _c.Parent.OnReceiveRaw()
+}
@@ -0,0 +1,117 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+//go:build linux
+
+package constrained
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// closerMock mock of Closer.
+type closerMock struct{ mock.Mock }
+
+// newCloserMock creates a new closerMock.
+func newCloserMock(tb testing.TB) *closerMock {
+	tb.Helper()
+
+	m := &closerMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *closerMock) Close() error {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() error); ok {
+		return _rf()
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *closerMock) OnClose() *closerCloseCall {
+	return &closerCloseCall{Call: _m.Mock.On("Close"), Parent: _m}
+}
+
+func (_m *closerMock) OnCloseRaw() *closerCloseCall {
+	return &closerCloseCall{Call: _m.Mock.On("Close"), Parent: _m}
+}
+
+type closerCloseCall struct {
+	*mock.Call
+	Parent *closerMock
+}
+
+func (_c *closerCloseCall) Panic(msg string) *closerCloseCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *closerCloseCall) Once() *closerCloseCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *closerCloseCall) Twice() *closerCloseCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *closerCloseCall) Times(i int) *closerCloseCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *closerCloseCall) WaitUntil(w <-chan time.Time) *closerCloseCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *closerCloseCall) After(d time.Duration) *closerCloseCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *closerCloseCall) Run(fn func(args mock.Arguments)) *closerCloseCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *closerCloseCall) Maybe() *closerCloseCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *closerCloseCall) TypedReturns(a error) *closerCloseCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *closerCloseCall) ReturnsFn(fn func() error) *closerCloseCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *closerCloseCall) TypedRun(fn func()) *closerCloseCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *closerCloseCall) OnClose() *closerCloseCall {
+	return _c.Parent.OnClose()
+}
+
+func (_c *closerCloseCall) OnCloseRaw() *closerCloseCall {
+	return _c.Parent.OnCloseRaw()
+}
@@ -0,0 +1,13 @@
+package constrained
+
+import "testing"
+
+// mocktail:Sender
+
+func TestSender(t *testing.T) {
+	var sender Sender = newSenderMock(t).
+		OnSend("hello").TypedReturns(nil).Once().
+		Parent
+
+	_ = sender.Send("hello")
+}
@@ -0,0 +1,117 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package d
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// writerMock mock of Writer.
+type writerMock struct{ mock.Mock }
+
+// NewWriterMock creates a new writerMock.
+func NewWriterMock(tb testing.TB) *writerMock {
+	tb.Helper()
+
+	m := &writerMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *writerMock) Write(p []byte) (int, error) {
+	_ret := _m.Called(p)
+
+	if _rf, ok := _ret.Get(0).(func([]byte) (int, error)); ok {
+		return _rf(p)
+	}
+
+	n := _ret.Int(0)
+	err := _ret.Error(1)
+
+	return n, err
+}
+
+func (_m *writerMock) OnWrite(p []byte) *writerWriteCall {
+	return &writerWriteCall{Call: _m.Mock.On("Write", p), Parent: _m}
+}
+
+func (_m *writerMock) OnWriteRaw(p interface{}) *writerWriteCall {
+	return &writerWriteCall{Call: _m.Mock.On("Write", p), Parent: _m}
+}
+
+type writerWriteCall struct {
+	*mock.Call
+	Parent *writerMock
+}
+
+func (_c *writerWriteCall) Panic(msg string) *writerWriteCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *writerWriteCall) Once() *writerWriteCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *writerWriteCall) Twice() *writerWriteCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *writerWriteCall) Times(i int) *writerWriteCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *writerWriteCall) WaitUntil(w <-chan time.Time) *writerWriteCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *writerWriteCall) After(d time.Duration) *writerWriteCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *writerWriteCall) Run(fn func(args mock.Arguments)) *writerWriteCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *writerWriteCall) Maybe() *writerWriteCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *writerWriteCall) TypedReturns(a int, b error) *writerWriteCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *writerWriteCall) ReturnsFn(fn func([]byte) (int, error)) *writerWriteCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *writerWriteCall) TypedRun(fn func([]byte)) *writerWriteCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_p, _ := args.Get(0).([]byte)
+		fn(_p)
+	})
+	return _c
+}
+
+func (_c *writerWriteCall) OnWrite(p []byte) *writerWriteCall {
+	return _c.Parent.OnWrite(p)
+}
+
+func (_c *writerWriteCall) OnWriteRaw(p interface{}) *writerWriteCall {
+	return _c.Parent.OnWriteRaw(p)
+}
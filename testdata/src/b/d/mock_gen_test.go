@@ -0,0 +1,439 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package d
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/mod/sumdb/tlog"
+)
+
+// readCloserMock mock of ReadCloser.
+type readCloserMock struct{ mock.Mock }
+
+// newReadCloserMock creates a new readCloserMock.
+func newReadCloserMock(tb testing.TB) *readCloserMock {
+	tb.Helper()
+
+	m := &readCloserMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *readCloserMock) Close() error {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() error); ok {
+		return _rf()
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *readCloserMock) OnClose() *readCloserCloseCall {
+	return &readCloserCloseCall{Call: _m.Mock.On("Close"), Parent: _m}
+}
+
+func (_m *readCloserMock) OnCloseRaw() *readCloserCloseCall {
+	return &readCloserCloseCall{Call: _m.Mock.On("Close"), Parent: _m}
+}
+
+type readCloserCloseCall struct {
+	*mock.Call
+	Parent *readCloserMock
+}
+
+func (_c *readCloserCloseCall) Panic(msg string) *readCloserCloseCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *readCloserCloseCall) Once() *readCloserCloseCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *readCloserCloseCall) Twice() *readCloserCloseCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *readCloserCloseCall) Times(i int) *readCloserCloseCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *readCloserCloseCall) WaitUntil(w <-chan time.Time) *readCloserCloseCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *readCloserCloseCall) After(d time.Duration) *readCloserCloseCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *readCloserCloseCall) Run(fn func(args mock.Arguments)) *readCloserCloseCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *readCloserCloseCall) Maybe() *readCloserCloseCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *readCloserCloseCall) TypedReturns(a error) *readCloserCloseCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *readCloserCloseCall) ReturnsFn(fn func() error) *readCloserCloseCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *readCloserCloseCall) TypedRun(fn func()) *readCloserCloseCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *readCloserCloseCall) OnClose() *readCloserCloseCall {
+	return _c.Parent.OnClose()
+}
+
+func (_c *readCloserCloseCall) OnRead(p []byte) *readCloserReadCall {
+	return _c.Parent.OnRead(p)
+}
+
+func (_c *readCloserCloseCall) OnCloseRaw() *readCloserCloseCall {
+	return _c.Parent.OnCloseRaw()
+}
+
+func (_c *readCloserCloseCall) OnReadRaw(p interface{}) *readCloserReadCall {
+	return _c.Parent.OnReadRaw(p)
+}
+
+func (_m *readCloserMock) Read(p []byte) (int, error) {
+	_ret := _m.Called(p)
+
+	if _rf, ok := _ret.Get(0).(func([]byte) (int, error)); ok {
+		return _rf(p)
+	}
+
+	n := _ret.Int(0)
+	err := _ret.Error(1)
+
+	return n, err
+}
+
+func (_m *readCloserMock) OnRead(p []byte) *readCloserReadCall {
+	return &readCloserReadCall{Call: _m.Mock.On("Read", p), Parent: _m}
+}
+
+func (_m *readCloserMock) OnReadRaw(p interface{}) *readCloserReadCall {
+	return &readCloserReadCall{Call: _m.Mock.On("Read", p), Parent: _m}
+}
+
+type readCloserReadCall struct {
+	*mock.Call
+	Parent *readCloserMock
+}
+
+func (_c *readCloserReadCall) Panic(msg string) *readCloserReadCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *readCloserReadCall) Once() *readCloserReadCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *readCloserReadCall) Twice() *readCloserReadCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *readCloserReadCall) Times(i int) *readCloserReadCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *readCloserReadCall) WaitUntil(w <-chan time.Time) *readCloserReadCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *readCloserReadCall) After(d time.Duration) *readCloserReadCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *readCloserReadCall) Run(fn func(args mock.Arguments)) *readCloserReadCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *readCloserReadCall) Maybe() *readCloserReadCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *readCloserReadCall) TypedReturns(a int, b error) *readCloserReadCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *readCloserReadCall) ReturnsFn(fn func([]byte) (int, error)) *readCloserReadCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *readCloserReadCall) TypedRun(fn func([]byte)) *readCloserReadCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_p, _ := args.Get(0).([]byte)
+		fn(_p)
+	})
+	return _c
+}
+
+func (_c *readCloserReadCall) OnClose() *readCloserCloseCall {
+	return _c.Parent.OnClose()
+}
+
+func (_c *readCloserReadCall) OnRead(p []byte) *readCloserReadCall {
+	return _c.Parent.OnRead(p)
+}
+
+func (_c *readCloserReadCall) OnCloseRaw() *readCloserCloseCall {
+	return _c.Parent.OnCloseRaw()
+}
+
+func (_c *readCloserReadCall) OnReadRaw(p interface{}) *readCloserReadCall {
+	return _c.Parent.OnReadRaw(p)
+}
+
+// roundTripperMock mock of RoundTripper.
+type roundTripperMock struct{ mock.Mock }
+
+// newRoundTripperMock creates a new roundTripperMock.
+func newRoundTripperMock(tb testing.TB) *roundTripperMock {
+	tb.Helper()
+
+	m := &roundTripperMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *roundTripperMock) RoundTrip(aParam *http.Request) (*http.Response, error) {
+	_ret := _m.Called(aParam)
+
+	if _rf, ok := _ret.Get(0).(func(*http.Request) (*http.Response, error)); ok {
+		return _rf(aParam)
+	}
+
+	_ra0, _ := _ret.Get(0).(*http.Response)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *roundTripperMock) OnRoundTrip(aParam *http.Request) *roundTripperRoundTripCall {
+	return &roundTripperRoundTripCall{Call: _m.Mock.On("RoundTrip", aParam), Parent: _m}
+}
+
+func (_m *roundTripperMock) OnRoundTripRaw(aParam interface{}) *roundTripperRoundTripCall {
+	return &roundTripperRoundTripCall{Call: _m.Mock.On("RoundTrip", aParam), Parent: _m}
+}
+
+type roundTripperRoundTripCall struct {
+	*mock.Call
+	Parent *roundTripperMock
+}
+
+func (_c *roundTripperRoundTripCall) Panic(msg string) *roundTripperRoundTripCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *roundTripperRoundTripCall) Once() *roundTripperRoundTripCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *roundTripperRoundTripCall) Twice() *roundTripperRoundTripCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *roundTripperRoundTripCall) Times(i int) *roundTripperRoundTripCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *roundTripperRoundTripCall) WaitUntil(w <-chan time.Time) *roundTripperRoundTripCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *roundTripperRoundTripCall) After(d time.Duration) *roundTripperRoundTripCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *roundTripperRoundTripCall) Run(fn func(args mock.Arguments)) *roundTripperRoundTripCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *roundTripperRoundTripCall) Maybe() *roundTripperRoundTripCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *roundTripperRoundTripCall) TypedReturns(a *http.Response, b error) *roundTripperRoundTripCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *roundTripperRoundTripCall) ReturnsFn(fn func(*http.Request) (*http.Response, error)) *roundTripperRoundTripCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *roundTripperRoundTripCall) TypedRun(fn func(*http.Request)) *roundTripperRoundTripCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_aParam, _ := args.Get(0).(*http.Request)
+		fn(_aParam)
+	})
+	return _c
+}
+
+func (_c *roundTripperRoundTripCall) OnRoundTrip(aParam *http.Request) *roundTripperRoundTripCall {
+	return _c.Parent.OnRoundTrip(aParam)
+}
+
+func (_c *roundTripperRoundTripCall) OnRoundTripRaw(aParam interface{}) *roundTripperRoundTripCall {
+	return _c.Parent.OnRoundTripRaw(aParam)
+}
+
+// hashReaderMock mock of HashReader.
+type hashReaderMock struct{ mock.Mock }
+
+// newHashReaderMock creates a new hashReaderMock.
+func newHashReaderMock(tb testing.TB) *hashReaderMock {
+	tb.Helper()
+
+	m := &hashReaderMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *hashReaderMock) ReadHashes(indexes []int64) ([]tlog.Hash, error) {
+	_ret := _m.Called(indexes)
+
+	if _rf, ok := _ret.Get(0).(func([]int64) ([]tlog.Hash, error)); ok {
+		return _rf(indexes)
+	}
+
+	_ra0, _ := _ret.Get(0).([]tlog.Hash)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *hashReaderMock) OnReadHashes(indexes []int64) *hashReaderReadHashesCall {
+	return &hashReaderReadHashesCall{Call: _m.Mock.On("ReadHashes", indexes), Parent: _m}
+}
+
+func (_m *hashReaderMock) OnReadHashesRaw(indexes interface{}) *hashReaderReadHashesCall {
+	return &hashReaderReadHashesCall{Call: _m.Mock.On("ReadHashes", indexes), Parent: _m}
+}
+
+type hashReaderReadHashesCall struct {
+	*mock.Call
+	Parent *hashReaderMock
+}
+
+func (_c *hashReaderReadHashesCall) Panic(msg string) *hashReaderReadHashesCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *hashReaderReadHashesCall) Once() *hashReaderReadHashesCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *hashReaderReadHashesCall) Twice() *hashReaderReadHashesCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *hashReaderReadHashesCall) Times(i int) *hashReaderReadHashesCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *hashReaderReadHashesCall) WaitUntil(w <-chan time.Time) *hashReaderReadHashesCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *hashReaderReadHashesCall) After(d time.Duration) *hashReaderReadHashesCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *hashReaderReadHashesCall) Run(fn func(args mock.Arguments)) *hashReaderReadHashesCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *hashReaderReadHashesCall) Maybe() *hashReaderReadHashesCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *hashReaderReadHashesCall) TypedReturns(a []tlog.Hash, b error) *hashReaderReadHashesCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *hashReaderReadHashesCall) ReturnsFn(fn func([]int64) ([]tlog.Hash, error)) *hashReaderReadHashesCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *hashReaderReadHashesCall) TypedRun(fn func([]int64)) *hashReaderReadHashesCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_indexes, _ := args.Get(0).([]int64)
+		fn(_indexes)
+	})
+	return _c
+}
+
+func (_c *hashReaderReadHashesCall) OnReadHashes(indexes []int64) *hashReaderReadHashesCall {
+	return _c.Parent.OnReadHashes(indexes)
+}
+
+func (_c *hashReaderReadHashesCall) OnReadHashesRaw(indexes interface{}) *hashReaderReadHashesCall {
+	return _c.Parent.OnReadHashesRaw(indexes)
+}
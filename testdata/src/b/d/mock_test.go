@@ -0,0 +1,51 @@
+package d
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"golang.org/x/mod/sumdb/tlog"
+)
+
+// mocktail:io.ReadCloser
+// mocktail:net/http.RoundTripper
+// mocktail:golang.org/x/mod/sumdb/tlog.HashReader
+// mocktail:io.Reader name=readerStub constructor=newReader file=reader_mock_test.go
+// mocktail:io.Writer exported
+
+func TestName(t *testing.T) {
+	var rc io.ReadCloser = newReadCloserMock(t).
+		OnRead([]byte("a")).TypedReturns(1, nil).Once().
+		OnClose().TypedReturns(nil).Once().
+		Parent
+
+	_, _ = rc.Read([]byte("a"))
+	_ = rc.Close()
+
+	req := &http.Request{}
+
+	var rt http.RoundTripper = newRoundTripperMock(t).
+		OnRoundTrip(req).TypedReturns(&http.Response{}, nil).Once().
+		Parent
+
+	_, _ = rt.RoundTrip(req)
+
+	var hr tlog.HashReader = newHashReaderMock(t).
+		OnReadHashes([]int64{1}).TypedReturns([]tlog.Hash{{}}, nil).Once().
+		Parent
+
+	_, _ = hr.ReadHashes([]int64{1})
+
+	var r io.Reader = newReader(t).
+		OnRead([]byte("a")).TypedReturns(1, nil).Once().
+		Parent
+
+	_, _ = r.Read([]byte("a"))
+
+	var w io.Writer = NewWriterMock(t).
+		OnWrite([]byte("a")).TypedReturns(1, nil).Once().
+		Parent
+
+	_, _ = w.Write([]byte("a"))
+}
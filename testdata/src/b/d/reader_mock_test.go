@@ -0,0 +1,117 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package d
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// readerStub mock of Reader.
+type readerStub struct{ mock.Mock }
+
+// newReader creates a new readerStub.
+func newReader(tb testing.TB) *readerStub {
+	tb.Helper()
+
+	m := &readerStub{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *readerStub) Read(p []byte) (int, error) {
+	_ret := _m.Called(p)
+
+	if _rf, ok := _ret.Get(0).(func([]byte) (int, error)); ok {
+		return _rf(p)
+	}
+
+	n := _ret.Int(0)
+	err := _ret.Error(1)
+
+	return n, err
+}
+
+func (_m *readerStub) OnRead(p []byte) *readerStubReadCall {
+	return &readerStubReadCall{Call: _m.Mock.On("Read", p), Parent: _m}
+}
+
+func (_m *readerStub) OnReadRaw(p interface{}) *readerStubReadCall {
+	return &readerStubReadCall{Call: _m.Mock.On("Read", p), Parent: _m}
+}
+
+type readerStubReadCall struct {
+	*mock.Call
+	Parent *readerStub
+}
+
+func (_c *readerStubReadCall) Panic(msg string) *readerStubReadCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *readerStubReadCall) Once() *readerStubReadCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *readerStubReadCall) Twice() *readerStubReadCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *readerStubReadCall) Times(i int) *readerStubReadCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *readerStubReadCall) WaitUntil(w <-chan time.Time) *readerStubReadCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *readerStubReadCall) After(d time.Duration) *readerStubReadCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *readerStubReadCall) Run(fn func(args mock.Arguments)) *readerStubReadCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *readerStubReadCall) Maybe() *readerStubReadCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *readerStubReadCall) TypedReturns(a int, b error) *readerStubReadCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *readerStubReadCall) ReturnsFn(fn func([]byte) (int, error)) *readerStubReadCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *readerStubReadCall) TypedRun(fn func([]byte)) *readerStubReadCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_p, _ := args.Get(0).([]byte)
+		fn(_p)
+	})
+	return _c
+}
+
+func (_c *readerStubReadCall) OnRead(p []byte) *readerStubReadCall {
+	return _c.Parent.OnRead(p)
+}
+
+func (_c *readerStubReadCall) OnReadRaw(p interface{}) *readerStubReadCall {
+	return _c.Parent.OnReadRaw(p)
+}
@@ -0,0 +1,4 @@
+package enterprise
+
+// Edition is the name of the edition.
+const Edition = "community"
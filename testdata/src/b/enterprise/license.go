@@ -0,0 +1,7 @@
+//go:build enterprise
+
+package enterprise
+
+type License interface {
+	Check(key string) error
+}
@@ -0,0 +1,116 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package enterprise
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// licenseMock mock of License.
+type licenseMock struct{ mock.Mock }
+
+// newLicenseMock creates a new licenseMock.
+func newLicenseMock(tb testing.TB) *licenseMock {
+	tb.Helper()
+
+	m := &licenseMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *licenseMock) Check(key string) error {
+	_ret := _m.Called(key)
+
+	if _rf, ok := _ret.Get(0).(func(string) error); ok {
+		return _rf(key)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *licenseMock) OnCheck(key string) *licenseCheckCall {
+	return &licenseCheckCall{Call: _m.Mock.On("Check", key), Parent: _m}
+}
+
+func (_m *licenseMock) OnCheckRaw(key interface{}) *licenseCheckCall {
+	return &licenseCheckCall{Call: _m.Mock.On("Check", key), Parent: _m}
+}
+
+type licenseCheckCall struct {
+	*mock.Call
+	Parent *licenseMock
+}
+
+func (_c *licenseCheckCall) Panic(msg string) *licenseCheckCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *licenseCheckCall) Once() *licenseCheckCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *licenseCheckCall) Twice() *licenseCheckCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *licenseCheckCall) Times(i int) *licenseCheckCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *licenseCheckCall) WaitUntil(w <-chan time.Time) *licenseCheckCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *licenseCheckCall) After(d time.Duration) *licenseCheckCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *licenseCheckCall) Run(fn func(args mock.Arguments)) *licenseCheckCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *licenseCheckCall) Maybe() *licenseCheckCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *licenseCheckCall) TypedReturns(a error) *licenseCheckCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *licenseCheckCall) ReturnsFn(fn func(string) error) *licenseCheckCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *licenseCheckCall) TypedRun(fn func(string)) *licenseCheckCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_key := args.String(0)
+		fn(_key)
+	})
+	return _c
+}
+
+func (_c *licenseCheckCall) OnCheck(key string) *licenseCheckCall {
+	return _c.Parent.OnCheck(key)
+}
+
+func (_c *licenseCheckCall) OnCheckRaw(key interface{}) *licenseCheckCall {
+	return _c.Parent.OnCheckRaw(key)
+}
@@ -0,0 +1,13 @@
+package enterprise
+
+import "testing"
+
+// mocktail:License
+
+func TestLicense(t *testing.T) {
+	license := newLicenseMock(t).
+		OnCheck("key").TypedReturns(nil).Once().
+		Parent
+
+	_ = license.Check("key")
+}
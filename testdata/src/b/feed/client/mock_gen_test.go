@@ -0,0 +1,116 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package client
+
+import (
+	"b/store"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// watcherMock mock of Watcher.
+type watcherMock struct{ mock.Mock }
+
+// newWatcherMock creates a new watcherMock.
+func newWatcherMock(tb testing.TB) *watcherMock {
+	tb.Helper()
+
+	m := &watcherMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *watcherMock) Watch() <-chan store.User {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() <-chan store.User); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(<-chan store.User)
+
+	return _ra0
+}
+
+func (_m *watcherMock) OnWatch() *watcherWatchCall {
+	return &watcherWatchCall{Call: _m.Mock.On("Watch"), Parent: _m}
+}
+
+func (_m *watcherMock) OnWatchRaw() *watcherWatchCall {
+	return &watcherWatchCall{Call: _m.Mock.On("Watch"), Parent: _m}
+}
+
+type watcherWatchCall struct {
+	*mock.Call
+	Parent *watcherMock
+}
+
+func (_c *watcherWatchCall) Panic(msg string) *watcherWatchCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *watcherWatchCall) Once() *watcherWatchCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *watcherWatchCall) Twice() *watcherWatchCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *watcherWatchCall) Times(i int) *watcherWatchCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *watcherWatchCall) WaitUntil(w <-chan time.Time) *watcherWatchCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *watcherWatchCall) After(d time.Duration) *watcherWatchCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *watcherWatchCall) Run(fn func(args mock.Arguments)) *watcherWatchCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *watcherWatchCall) Maybe() *watcherWatchCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *watcherWatchCall) TypedReturns(a <-chan store.User) *watcherWatchCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *watcherWatchCall) ReturnsFn(fn func() <-chan store.User) *watcherWatchCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *watcherWatchCall) TypedRun(fn func()) *watcherWatchCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *watcherWatchCall) OnWatch() *watcherWatchCall {
+	return _c.Parent.OnWatch()
+}
+
+func (_c *watcherWatchCall) OnWatchRaw() *watcherWatchCall {
+	return _c.Parent.OnWatchRaw()
+}
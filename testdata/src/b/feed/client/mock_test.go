@@ -0,0 +1,21 @@
+package client
+
+import (
+	"testing"
+
+	"b/store"
+)
+
+// mocktail:feed.Watcher
+
+func TestWatcher(t *testing.T) {
+	users := make(chan store.User)
+
+	watcher := newWatcherMock(t).
+		OnWatch().TypedReturns(users).Once().
+		Parent
+
+	if watcher.Watch() != users {
+		t.Fatal("unexpected channel")
+	}
+}
@@ -0,0 +1,7 @@
+package feed
+
+import "b/store"
+
+type Watcher interface {
+	Watch() <-chan store.User
+}
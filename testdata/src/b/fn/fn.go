@@ -0,0 +1,16 @@
+package fn
+
+import (
+	"context"
+	"time"
+)
+
+type Request struct {
+	Path string
+}
+
+type Clock func() time.Time
+
+type Handler func(ctx context.Context, req Request) error
+
+type Mapper[T any] func(T) string
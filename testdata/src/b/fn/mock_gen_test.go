@@ -0,0 +1,343 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package fn
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// clockMock mock of Clock.
+type clockMock struct{ mock.Mock }
+
+// newClockMock creates a new clockMock.
+func newClockMock(tb testing.TB) *clockMock {
+	tb.Helper()
+
+	m := &clockMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+// Func returns a Clock calling the mock.
+func (_m *clockMock) Func() Clock {
+	return _m.Execute
+}
+
+func (_m *clockMock) Execute() time.Time {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() time.Time); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(time.Time)
+
+	return _ra0
+}
+
+func (_m *clockMock) OnExecute() *clockExecuteCall {
+	return &clockExecuteCall{Call: _m.Mock.On("Execute"), Parent: _m}
+}
+
+func (_m *clockMock) OnExecuteRaw() *clockExecuteCall {
+	return &clockExecuteCall{Call: _m.Mock.On("Execute"), Parent: _m}
+}
+
+type clockExecuteCall struct {
+	*mock.Call
+	Parent *clockMock
+}
+
+func (_c *clockExecuteCall) Panic(msg string) *clockExecuteCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *clockExecuteCall) Once() *clockExecuteCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *clockExecuteCall) Twice() *clockExecuteCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *clockExecuteCall) Times(i int) *clockExecuteCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *clockExecuteCall) WaitUntil(w <-chan time.Time) *clockExecuteCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *clockExecuteCall) After(d time.Duration) *clockExecuteCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *clockExecuteCall) Run(fn func(args mock.Arguments)) *clockExecuteCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *clockExecuteCall) Maybe() *clockExecuteCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *clockExecuteCall) TypedReturns(a time.Time) *clockExecuteCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *clockExecuteCall) ReturnsFn(fn func() time.Time) *clockExecuteCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *clockExecuteCall) TypedRun(fn func()) *clockExecuteCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *clockExecuteCall) OnExecute() *clockExecuteCall {
+	return _c.Parent.OnExecute()
+}
+
+func (_c *clockExecuteCall) OnExecuteRaw() *clockExecuteCall {
+	return _c.Parent.OnExecuteRaw()
+}
+
+// handlerMock mock of Handler.
+type handlerMock struct{ mock.Mock }
+
+// newHandlerMock creates a new handlerMock.
+func newHandlerMock(tb testing.TB) *handlerMock {
+	tb.Helper()
+
+	m := &handlerMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+// Func returns a Handler calling the mock.
+func (_m *handlerMock) Func() Handler {
+	return _m.Execute
+}
+
+func (_m *handlerMock) Execute(_ context.Context, req Request) error {
+	_ret := _m.Called(req)
+
+	if _rf, ok := _ret.Get(0).(func(Request) error); ok {
+		return _rf(req)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *handlerMock) OnExecute(req Request) *handlerExecuteCall {
+	return &handlerExecuteCall{Call: _m.Mock.On("Execute", req), Parent: _m}
+}
+
+func (_m *handlerMock) OnExecuteRaw(req interface{}) *handlerExecuteCall {
+	return &handlerExecuteCall{Call: _m.Mock.On("Execute", req), Parent: _m}
+}
+
+type handlerExecuteCall struct {
+	*mock.Call
+	Parent *handlerMock
+}
+
+func (_c *handlerExecuteCall) Panic(msg string) *handlerExecuteCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *handlerExecuteCall) Once() *handlerExecuteCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *handlerExecuteCall) Twice() *handlerExecuteCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *handlerExecuteCall) Times(i int) *handlerExecuteCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *handlerExecuteCall) WaitUntil(w <-chan time.Time) *handlerExecuteCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *handlerExecuteCall) After(d time.Duration) *handlerExecuteCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *handlerExecuteCall) Run(fn func(args mock.Arguments)) *handlerExecuteCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *handlerExecuteCall) Maybe() *handlerExecuteCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *handlerExecuteCall) TypedReturns(a error) *handlerExecuteCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *handlerExecuteCall) ReturnsFn(fn func(Request) error) *handlerExecuteCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *handlerExecuteCall) TypedRun(fn func(Request)) *handlerExecuteCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_req, _ := args.Get(0).(Request)
+		fn(_req)
+	})
+	return _c
+}
+
+func (_c *handlerExecuteCall) OnExecute(req Request) *handlerExecuteCall {
+	return _c.Parent.OnExecute(req)
+}
+
+func (_c *handlerExecuteCall) OnExecuteRaw(req interface{}) *handlerExecuteCall {
+	return _c.Parent.OnExecuteRaw(req)
+}
+
+// mapperMock mock of Mapper.
+type mapperMock[T any] struct{ mock.Mock }
+
+// newMapperMock creates a new mapperMock.
+func newMapperMock[T any](tb testing.TB) *mapperMock[T] {
+	tb.Helper()
+
+	m := &mapperMock[T]{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+// Func returns a Mapper[T] calling the mock.
+func (_m *mapperMock[T]) Func() Mapper[T] {
+	return _m.Execute
+}
+
+func (_m *mapperMock[T]) Execute(aParam T) string {
+	_ret := _m.Called(aParam)
+
+	if _rf, ok := _ret.Get(0).(func(T) string); ok {
+		return _rf(aParam)
+	}
+
+	_ra0 := _ret.String(0)
+
+	return _ra0
+}
+
+func (_m *mapperMock[T]) OnExecute(aParam T) *mapperExecuteCall[T] {
+	return &mapperExecuteCall[T]{Call: _m.Mock.On("Execute", aParam), Parent: _m}
+}
+
+func (_m *mapperMock[T]) OnExecuteRaw(aParam interface{}) *mapperExecuteCall[T] {
+	return &mapperExecuteCall[T]{Call: _m.Mock.On("Execute", aParam), Parent: _m}
+}
+
+type mapperExecuteCall[T any] struct {
+	*mock.Call
+	Parent *mapperMock[T]
+}
+
+func (_c *mapperExecuteCall[T]) Panic(msg string) *mapperExecuteCall[T] {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *mapperExecuteCall[T]) Once() *mapperExecuteCall[T] {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *mapperExecuteCall[T]) Twice() *mapperExecuteCall[T] {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *mapperExecuteCall[T]) Times(i int) *mapperExecuteCall[T] {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *mapperExecuteCall[T]) WaitUntil(w <-chan time.Time) *mapperExecuteCall[T] {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *mapperExecuteCall[T]) After(d time.Duration) *mapperExecuteCall[T] {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *mapperExecuteCall[T]) Run(fn func(args mock.Arguments)) *mapperExecuteCall[T] {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *mapperExecuteCall[T]) Maybe() *mapperExecuteCall[T] {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *mapperExecuteCall[T]) TypedReturns(a string) *mapperExecuteCall[T] {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *mapperExecuteCall[T]) ReturnsFn(fn func(T) string) *mapperExecuteCall[T] {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *mapperExecuteCall[T]) TypedRun(fn func(T)) *mapperExecuteCall[T] {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_aParam, _ := args.Get(0).(T)
+		fn(_aParam)
+	})
+	return _c
+}
+
+func (_c *mapperExecuteCall[T]) OnExecute(aParam T) *mapperExecuteCall[T] {
+	return _c.Parent.OnExecute(aParam)
+}
+
+func (_c *mapperExecuteCall[T]) OnExecuteRaw(aParam interface{}) *mapperExecuteCall[T] {
+	return _c.Parent.OnExecuteRaw(aParam)
+}
@@ -0,0 +1,33 @@
+package fn
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// mocktail:Clock
+// mocktail:Handler
+// mocktail:Mapper
+
+func TestFunc(t *testing.T) {
+	now := time.Now()
+
+	var clock Clock = newClockMock(t).
+		OnExecute().TypedReturns(now).Once().
+		Parent.Func()
+
+	clock()
+
+	var handler Handler = newHandlerMock(t).
+		OnExecute(Request{Path: "/"}).TypedReturns(nil).Once().
+		Parent.Func()
+
+	_ = handler(context.Background(), Request{Path: "/"})
+
+	var mapper Mapper[int] = newMapperMock[int](t).
+		OnExecute(1).TypedReturns("1").Once().
+		Parent.Func()
+
+	mapper(1)
+}
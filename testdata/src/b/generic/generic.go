@@ -0,0 +1,13 @@
+package generic
+
+import "context"
+
+type User struct {
+	Name string
+}
+
+type Repository[E any, K comparable] interface {
+	Get(ctx context.Context, id K) (E, error)
+	List(ctx context.Context) ([]E, error)
+	Save(ctx context.Context, entity E) error
+}
@@ -0,0 +1,1247 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package generic
+
+import (
+	fn2 "b/fn"
+	"b/store"
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// userIntRepositoryMock mock of UserIntRepository.
+type userIntRepositoryMock struct{ mock.Mock }
+
+// newUserIntRepositoryMock creates a new userIntRepositoryMock.
+func newUserIntRepositoryMock(tb testing.TB) *userIntRepositoryMock {
+	tb.Helper()
+
+	m := &userIntRepositoryMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *userIntRepositoryMock) Get(_ context.Context, id int) (User, error) {
+	_ret := _m.Called(id)
+
+	if _rf, ok := _ret.Get(0).(func(int) (User, error)); ok {
+		return _rf(id)
+	}
+
+	_ra0, _ := _ret.Get(0).(User)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *userIntRepositoryMock) OnGet(id int) *userIntRepositoryGetCall {
+	return &userIntRepositoryGetCall{Call: _m.Mock.On("Get", id), Parent: _m}
+}
+
+func (_m *userIntRepositoryMock) OnGetRaw(id interface{}) *userIntRepositoryGetCall {
+	return &userIntRepositoryGetCall{Call: _m.Mock.On("Get", id), Parent: _m}
+}
+
+type userIntRepositoryGetCall struct {
+	*mock.Call
+	Parent *userIntRepositoryMock
+}
+
+func (_c *userIntRepositoryGetCall) Panic(msg string) *userIntRepositoryGetCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *userIntRepositoryGetCall) Once() *userIntRepositoryGetCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *userIntRepositoryGetCall) Twice() *userIntRepositoryGetCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *userIntRepositoryGetCall) Times(i int) *userIntRepositoryGetCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *userIntRepositoryGetCall) WaitUntil(w <-chan time.Time) *userIntRepositoryGetCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *userIntRepositoryGetCall) After(d time.Duration) *userIntRepositoryGetCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *userIntRepositoryGetCall) Run(fn func(args mock.Arguments)) *userIntRepositoryGetCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *userIntRepositoryGetCall) Maybe() *userIntRepositoryGetCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *userIntRepositoryGetCall) TypedReturns(a User, b error) *userIntRepositoryGetCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *userIntRepositoryGetCall) ReturnsFn(fn func(int) (User, error)) *userIntRepositoryGetCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *userIntRepositoryGetCall) TypedRun(fn func(int)) *userIntRepositoryGetCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_id := args.Int(0)
+		fn(_id)
+	})
+	return _c
+}
+
+func (_c *userIntRepositoryGetCall) OnGet(id int) *userIntRepositoryGetCall {
+	return _c.Parent.OnGet(id)
+}
+
+func (_c *userIntRepositoryGetCall) OnList() *userIntRepositoryListCall {
+	return _c.Parent.OnList()
+}
+
+func (_c *userIntRepositoryGetCall) OnSave(entity User) *userIntRepositorySaveCall {
+	return _c.Parent.OnSave(entity)
+}
+
+func (_c *userIntRepositoryGetCall) OnGetRaw(id interface{}) *userIntRepositoryGetCall {
+	return _c.Parent.OnGetRaw(id)
+}
+
+func (_c *userIntRepositoryGetCall) OnListRaw() *userIntRepositoryListCall {
+	return _c.Parent.OnListRaw()
+}
+
+func (_c *userIntRepositoryGetCall) OnSaveRaw(entity interface{}) *userIntRepositorySaveCall {
+	return _c.Parent.OnSaveRaw(entity)
+}
+
+func (_m *userIntRepositoryMock) List(_ context.Context) ([]User, error) {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() ([]User, error)); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).([]User)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *userIntRepositoryMock) OnList() *userIntRepositoryListCall {
+	return &userIntRepositoryListCall{Call: _m.Mock.On("List"), Parent: _m}
+}
+
+func (_m *userIntRepositoryMock) OnListRaw() *userIntRepositoryListCall {
+	return &userIntRepositoryListCall{Call: _m.Mock.On("List"), Parent: _m}
+}
+
+type userIntRepositoryListCall struct {
+	*mock.Call
+	Parent *userIntRepositoryMock
+}
+
+func (_c *userIntRepositoryListCall) Panic(msg string) *userIntRepositoryListCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *userIntRepositoryListCall) Once() *userIntRepositoryListCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *userIntRepositoryListCall) Twice() *userIntRepositoryListCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *userIntRepositoryListCall) Times(i int) *userIntRepositoryListCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *userIntRepositoryListCall) WaitUntil(w <-chan time.Time) *userIntRepositoryListCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *userIntRepositoryListCall) After(d time.Duration) *userIntRepositoryListCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *userIntRepositoryListCall) Run(fn func(args mock.Arguments)) *userIntRepositoryListCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *userIntRepositoryListCall) Maybe() *userIntRepositoryListCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *userIntRepositoryListCall) TypedReturns(a []User, b error) *userIntRepositoryListCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *userIntRepositoryListCall) ReturnsFn(fn func() ([]User, error)) *userIntRepositoryListCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *userIntRepositoryListCall) TypedRun(fn func()) *userIntRepositoryListCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *userIntRepositoryListCall) OnGet(id int) *userIntRepositoryGetCall {
+	return _c.Parent.OnGet(id)
+}
+
+func (_c *userIntRepositoryListCall) OnList() *userIntRepositoryListCall {
+	return _c.Parent.OnList()
+}
+
+func (_c *userIntRepositoryListCall) OnSave(entity User) *userIntRepositorySaveCall {
+	return _c.Parent.OnSave(entity)
+}
+
+func (_c *userIntRepositoryListCall) OnGetRaw(id interface{}) *userIntRepositoryGetCall {
+	return _c.Parent.OnGetRaw(id)
+}
+
+func (_c *userIntRepositoryListCall) OnListRaw() *userIntRepositoryListCall {
+	return _c.Parent.OnListRaw()
+}
+
+func (_c *userIntRepositoryListCall) OnSaveRaw(entity interface{}) *userIntRepositorySaveCall {
+	return _c.Parent.OnSaveRaw(entity)
+}
+
+func (_m *userIntRepositoryMock) Save(_ context.Context, entity User) error {
+	_ret := _m.Called(entity)
+
+	if _rf, ok := _ret.Get(0).(func(User) error); ok {
+		return _rf(entity)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *userIntRepositoryMock) OnSave(entity User) *userIntRepositorySaveCall {
+	return &userIntRepositorySaveCall{Call: _m.Mock.On("Save", entity), Parent: _m}
+}
+
+func (_m *userIntRepositoryMock) OnSaveRaw(entity interface{}) *userIntRepositorySaveCall {
+	return &userIntRepositorySaveCall{Call: _m.Mock.On("Save", entity), Parent: _m}
+}
+
+type userIntRepositorySaveCall struct {
+	*mock.Call
+	Parent *userIntRepositoryMock
+}
+
+func (_c *userIntRepositorySaveCall) Panic(msg string) *userIntRepositorySaveCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *userIntRepositorySaveCall) Once() *userIntRepositorySaveCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *userIntRepositorySaveCall) Twice() *userIntRepositorySaveCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *userIntRepositorySaveCall) Times(i int) *userIntRepositorySaveCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *userIntRepositorySaveCall) WaitUntil(w <-chan time.Time) *userIntRepositorySaveCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *userIntRepositorySaveCall) After(d time.Duration) *userIntRepositorySaveCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *userIntRepositorySaveCall) Run(fn func(args mock.Arguments)) *userIntRepositorySaveCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *userIntRepositorySaveCall) Maybe() *userIntRepositorySaveCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *userIntRepositorySaveCall) TypedReturns(a error) *userIntRepositorySaveCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *userIntRepositorySaveCall) ReturnsFn(fn func(User) error) *userIntRepositorySaveCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *userIntRepositorySaveCall) TypedRun(fn func(User)) *userIntRepositorySaveCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_entity, _ := args.Get(0).(User)
+		fn(_entity)
+	})
+	return _c
+}
+
+func (_c *userIntRepositorySaveCall) OnGet(id int) *userIntRepositoryGetCall {
+	return _c.Parent.OnGet(id)
+}
+
+func (_c *userIntRepositorySaveCall) OnList() *userIntRepositoryListCall {
+	return _c.Parent.OnList()
+}
+
+func (_c *userIntRepositorySaveCall) OnSave(entity User) *userIntRepositorySaveCall {
+	return _c.Parent.OnSave(entity)
+}
+
+func (_c *userIntRepositorySaveCall) OnGetRaw(id interface{}) *userIntRepositoryGetCall {
+	return _c.Parent.OnGetRaw(id)
+}
+
+func (_c *userIntRepositorySaveCall) OnListRaw() *userIntRepositoryListCall {
+	return _c.Parent.OnListRaw()
+}
+
+func (_c *userIntRepositorySaveCall) OnSaveRaw(entity interface{}) *userIntRepositorySaveCall {
+	return _c.Parent.OnSaveRaw(entity)
+}
+
+// storeUserRepositoryMock mock of UserStringRepository.
+type storeUserRepositoryMock struct{ mock.Mock }
+
+// newStoreUserRepositoryMock creates a new storeUserRepositoryMock.
+func newStoreUserRepositoryMock(tb testing.TB) *storeUserRepositoryMock {
+	tb.Helper()
+
+	m := &storeUserRepositoryMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *storeUserRepositoryMock) Get(_ context.Context, id string) (store.User, error) {
+	_ret := _m.Called(id)
+
+	if _rf, ok := _ret.Get(0).(func(string) (store.User, error)); ok {
+		return _rf(id)
+	}
+
+	_ra0, _ := _ret.Get(0).(store.User)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *storeUserRepositoryMock) OnGet(id string) *storeUserRepositoryGetCall {
+	return &storeUserRepositoryGetCall{Call: _m.Mock.On("Get", id), Parent: _m}
+}
+
+func (_m *storeUserRepositoryMock) OnGetRaw(id interface{}) *storeUserRepositoryGetCall {
+	return &storeUserRepositoryGetCall{Call: _m.Mock.On("Get", id), Parent: _m}
+}
+
+type storeUserRepositoryGetCall struct {
+	*mock.Call
+	Parent *storeUserRepositoryMock
+}
+
+func (_c *storeUserRepositoryGetCall) Panic(msg string) *storeUserRepositoryGetCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *storeUserRepositoryGetCall) Once() *storeUserRepositoryGetCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *storeUserRepositoryGetCall) Twice() *storeUserRepositoryGetCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *storeUserRepositoryGetCall) Times(i int) *storeUserRepositoryGetCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *storeUserRepositoryGetCall) WaitUntil(w <-chan time.Time) *storeUserRepositoryGetCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *storeUserRepositoryGetCall) After(d time.Duration) *storeUserRepositoryGetCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *storeUserRepositoryGetCall) Run(fn func(args mock.Arguments)) *storeUserRepositoryGetCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *storeUserRepositoryGetCall) Maybe() *storeUserRepositoryGetCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *storeUserRepositoryGetCall) TypedReturns(a store.User, b error) *storeUserRepositoryGetCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *storeUserRepositoryGetCall) ReturnsFn(fn func(string) (store.User, error)) *storeUserRepositoryGetCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *storeUserRepositoryGetCall) TypedRun(fn func(string)) *storeUserRepositoryGetCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_id := args.String(0)
+		fn(_id)
+	})
+	return _c
+}
+
+func (_c *storeUserRepositoryGetCall) OnGet(id string) *storeUserRepositoryGetCall {
+	return _c.Parent.OnGet(id)
+}
+
+func (_c *storeUserRepositoryGetCall) OnList() *storeUserRepositoryListCall {
+	return _c.Parent.OnList()
+}
+
+func (_c *storeUserRepositoryGetCall) OnSave(entity store.User) *storeUserRepositorySaveCall {
+	return _c.Parent.OnSave(entity)
+}
+
+func (_c *storeUserRepositoryGetCall) OnGetRaw(id interface{}) *storeUserRepositoryGetCall {
+	return _c.Parent.OnGetRaw(id)
+}
+
+func (_c *storeUserRepositoryGetCall) OnListRaw() *storeUserRepositoryListCall {
+	return _c.Parent.OnListRaw()
+}
+
+func (_c *storeUserRepositoryGetCall) OnSaveRaw(entity interface{}) *storeUserRepositorySaveCall {
+	return _c.Parent.OnSaveRaw(entity)
+}
+
+func (_m *storeUserRepositoryMock) List(_ context.Context) ([]store.User, error) {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() ([]store.User, error)); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).([]store.User)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *storeUserRepositoryMock) OnList() *storeUserRepositoryListCall {
+	return &storeUserRepositoryListCall{Call: _m.Mock.On("List"), Parent: _m}
+}
+
+func (_m *storeUserRepositoryMock) OnListRaw() *storeUserRepositoryListCall {
+	return &storeUserRepositoryListCall{Call: _m.Mock.On("List"), Parent: _m}
+}
+
+type storeUserRepositoryListCall struct {
+	*mock.Call
+	Parent *storeUserRepositoryMock
+}
+
+func (_c *storeUserRepositoryListCall) Panic(msg string) *storeUserRepositoryListCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *storeUserRepositoryListCall) Once() *storeUserRepositoryListCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *storeUserRepositoryListCall) Twice() *storeUserRepositoryListCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *storeUserRepositoryListCall) Times(i int) *storeUserRepositoryListCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *storeUserRepositoryListCall) WaitUntil(w <-chan time.Time) *storeUserRepositoryListCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *storeUserRepositoryListCall) After(d time.Duration) *storeUserRepositoryListCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *storeUserRepositoryListCall) Run(fn func(args mock.Arguments)) *storeUserRepositoryListCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *storeUserRepositoryListCall) Maybe() *storeUserRepositoryListCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *storeUserRepositoryListCall) TypedReturns(a []store.User, b error) *storeUserRepositoryListCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *storeUserRepositoryListCall) ReturnsFn(fn func() ([]store.User, error)) *storeUserRepositoryListCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *storeUserRepositoryListCall) TypedRun(fn func()) *storeUserRepositoryListCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *storeUserRepositoryListCall) OnGet(id string) *storeUserRepositoryGetCall {
+	return _c.Parent.OnGet(id)
+}
+
+func (_c *storeUserRepositoryListCall) OnList() *storeUserRepositoryListCall {
+	return _c.Parent.OnList()
+}
+
+func (_c *storeUserRepositoryListCall) OnSave(entity store.User) *storeUserRepositorySaveCall {
+	return _c.Parent.OnSave(entity)
+}
+
+func (_c *storeUserRepositoryListCall) OnGetRaw(id interface{}) *storeUserRepositoryGetCall {
+	return _c.Parent.OnGetRaw(id)
+}
+
+func (_c *storeUserRepositoryListCall) OnListRaw() *storeUserRepositoryListCall {
+	return _c.Parent.OnListRaw()
+}
+
+func (_c *storeUserRepositoryListCall) OnSaveRaw(entity interface{}) *storeUserRepositorySaveCall {
+	return _c.Parent.OnSaveRaw(entity)
+}
+
+func (_m *storeUserRepositoryMock) Save(_ context.Context, entity store.User) error {
+	_ret := _m.Called(entity)
+
+	if _rf, ok := _ret.Get(0).(func(store.User) error); ok {
+		return _rf(entity)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *storeUserRepositoryMock) OnSave(entity store.User) *storeUserRepositorySaveCall {
+	return &storeUserRepositorySaveCall{Call: _m.Mock.On("Save", entity), Parent: _m}
+}
+
+func (_m *storeUserRepositoryMock) OnSaveRaw(entity interface{}) *storeUserRepositorySaveCall {
+	return &storeUserRepositorySaveCall{Call: _m.Mock.On("Save", entity), Parent: _m}
+}
+
+type storeUserRepositorySaveCall struct {
+	*mock.Call
+	Parent *storeUserRepositoryMock
+}
+
+func (_c *storeUserRepositorySaveCall) Panic(msg string) *storeUserRepositorySaveCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *storeUserRepositorySaveCall) Once() *storeUserRepositorySaveCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *storeUserRepositorySaveCall) Twice() *storeUserRepositorySaveCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *storeUserRepositorySaveCall) Times(i int) *storeUserRepositorySaveCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *storeUserRepositorySaveCall) WaitUntil(w <-chan time.Time) *storeUserRepositorySaveCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *storeUserRepositorySaveCall) After(d time.Duration) *storeUserRepositorySaveCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *storeUserRepositorySaveCall) Run(fn func(args mock.Arguments)) *storeUserRepositorySaveCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *storeUserRepositorySaveCall) Maybe() *storeUserRepositorySaveCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *storeUserRepositorySaveCall) TypedReturns(a error) *storeUserRepositorySaveCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *storeUserRepositorySaveCall) ReturnsFn(fn func(store.User) error) *storeUserRepositorySaveCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *storeUserRepositorySaveCall) TypedRun(fn func(store.User)) *storeUserRepositorySaveCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_entity, _ := args.Get(0).(store.User)
+		fn(_entity)
+	})
+	return _c
+}
+
+func (_c *storeUserRepositorySaveCall) OnGet(id string) *storeUserRepositoryGetCall {
+	return _c.Parent.OnGet(id)
+}
+
+func (_c *storeUserRepositorySaveCall) OnList() *storeUserRepositoryListCall {
+	return _c.Parent.OnList()
+}
+
+func (_c *storeUserRepositorySaveCall) OnSave(entity store.User) *storeUserRepositorySaveCall {
+	return _c.Parent.OnSave(entity)
+}
+
+func (_c *storeUserRepositorySaveCall) OnGetRaw(id interface{}) *storeUserRepositoryGetCall {
+	return _c.Parent.OnGetRaw(id)
+}
+
+func (_c *storeUserRepositorySaveCall) OnListRaw() *storeUserRepositoryListCall {
+	return _c.Parent.OnListRaw()
+}
+
+func (_c *storeUserRepositorySaveCall) OnSaveRaw(entity interface{}) *storeUserRepositorySaveCall {
+	return _c.Parent.OnSaveRaw(entity)
+}
+
+// stringByteSliceMapStringRepositoryMock mock of StringByteSliceMapStringRepository.
+type stringByteSliceMapStringRepositoryMock struct{ mock.Mock }
+
+// newStringByteSliceMapStringRepositoryMock creates a new stringByteSliceMapStringRepositoryMock.
+func newStringByteSliceMapStringRepositoryMock(tb testing.TB) *stringByteSliceMapStringRepositoryMock {
+	tb.Helper()
+
+	m := &stringByteSliceMapStringRepositoryMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *stringByteSliceMapStringRepositoryMock) Get(_ context.Context, id string) (map[string][]byte, error) {
+	_ret := _m.Called(id)
+
+	if _rf, ok := _ret.Get(0).(func(string) (map[string][]byte, error)); ok {
+		return _rf(id)
+	}
+
+	_ra0, _ := _ret.Get(0).(map[string][]byte)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *stringByteSliceMapStringRepositoryMock) OnGet(id string) *stringByteSliceMapStringRepositoryGetCall {
+	return &stringByteSliceMapStringRepositoryGetCall{Call: _m.Mock.On("Get", id), Parent: _m}
+}
+
+func (_m *stringByteSliceMapStringRepositoryMock) OnGetRaw(id interface{}) *stringByteSliceMapStringRepositoryGetCall {
+	return &stringByteSliceMapStringRepositoryGetCall{Call: _m.Mock.On("Get", id), Parent: _m}
+}
+
+type stringByteSliceMapStringRepositoryGetCall struct {
+	*mock.Call
+	Parent *stringByteSliceMapStringRepositoryMock
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) Panic(msg string) *stringByteSliceMapStringRepositoryGetCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) Once() *stringByteSliceMapStringRepositoryGetCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) Twice() *stringByteSliceMapStringRepositoryGetCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) Times(i int) *stringByteSliceMapStringRepositoryGetCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) WaitUntil(w <-chan time.Time) *stringByteSliceMapStringRepositoryGetCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) After(d time.Duration) *stringByteSliceMapStringRepositoryGetCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) Run(fn func(args mock.Arguments)) *stringByteSliceMapStringRepositoryGetCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) Maybe() *stringByteSliceMapStringRepositoryGetCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) TypedReturns(a map[string][]byte, b error) *stringByteSliceMapStringRepositoryGetCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) ReturnsFn(fn func(string) (map[string][]byte, error)) *stringByteSliceMapStringRepositoryGetCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) TypedRun(fn func(string)) *stringByteSliceMapStringRepositoryGetCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_id := args.String(0)
+		fn(_id)
+	})
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) OnGet(id string) *stringByteSliceMapStringRepositoryGetCall {
+	return _c.Parent.OnGet(id)
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) OnList() *stringByteSliceMapStringRepositoryListCall {
+	return _c.Parent.OnList()
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) OnSave(entity map[string][]byte) *stringByteSliceMapStringRepositorySaveCall {
+	return _c.Parent.OnSave(entity)
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) OnGetRaw(id interface{}) *stringByteSliceMapStringRepositoryGetCall {
+	return _c.Parent.OnGetRaw(id)
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) OnListRaw() *stringByteSliceMapStringRepositoryListCall {
+	return _c.Parent.OnListRaw()
+}
+
+func (_c *stringByteSliceMapStringRepositoryGetCall) OnSaveRaw(entity interface{}) *stringByteSliceMapStringRepositorySaveCall {
+	return _c.Parent.OnSaveRaw(entity)
+}
+
+func (_m *stringByteSliceMapStringRepositoryMock) List(_ context.Context) ([]map[string][]byte, error) {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() ([]map[string][]byte, error)); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).([]map[string][]byte)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *stringByteSliceMapStringRepositoryMock) OnList() *stringByteSliceMapStringRepositoryListCall {
+	return &stringByteSliceMapStringRepositoryListCall{Call: _m.Mock.On("List"), Parent: _m}
+}
+
+func (_m *stringByteSliceMapStringRepositoryMock) OnListRaw() *stringByteSliceMapStringRepositoryListCall {
+	return &stringByteSliceMapStringRepositoryListCall{Call: _m.Mock.On("List"), Parent: _m}
+}
+
+type stringByteSliceMapStringRepositoryListCall struct {
+	*mock.Call
+	Parent *stringByteSliceMapStringRepositoryMock
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) Panic(msg string) *stringByteSliceMapStringRepositoryListCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) Once() *stringByteSliceMapStringRepositoryListCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) Twice() *stringByteSliceMapStringRepositoryListCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) Times(i int) *stringByteSliceMapStringRepositoryListCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) WaitUntil(w <-chan time.Time) *stringByteSliceMapStringRepositoryListCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) After(d time.Duration) *stringByteSliceMapStringRepositoryListCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) Run(fn func(args mock.Arguments)) *stringByteSliceMapStringRepositoryListCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) Maybe() *stringByteSliceMapStringRepositoryListCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) TypedReturns(a []map[string][]byte, b error) *stringByteSliceMapStringRepositoryListCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) ReturnsFn(fn func() ([]map[string][]byte, error)) *stringByteSliceMapStringRepositoryListCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) TypedRun(fn func()) *stringByteSliceMapStringRepositoryListCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) OnGet(id string) *stringByteSliceMapStringRepositoryGetCall {
+	return _c.Parent.OnGet(id)
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) OnList() *stringByteSliceMapStringRepositoryListCall {
+	return _c.Parent.OnList()
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) OnSave(entity map[string][]byte) *stringByteSliceMapStringRepositorySaveCall {
+	return _c.Parent.OnSave(entity)
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) OnGetRaw(id interface{}) *stringByteSliceMapStringRepositoryGetCall {
+	return _c.Parent.OnGetRaw(id)
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) OnListRaw() *stringByteSliceMapStringRepositoryListCall {
+	return _c.Parent.OnListRaw()
+}
+
+func (_c *stringByteSliceMapStringRepositoryListCall) OnSaveRaw(entity interface{}) *stringByteSliceMapStringRepositorySaveCall {
+	return _c.Parent.OnSaveRaw(entity)
+}
+
+func (_m *stringByteSliceMapStringRepositoryMock) Save(_ context.Context, entity map[string][]byte) error {
+	_ret := _m.Called(entity)
+
+	if _rf, ok := _ret.Get(0).(func(map[string][]byte) error); ok {
+		return _rf(entity)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *stringByteSliceMapStringRepositoryMock) OnSave(entity map[string][]byte) *stringByteSliceMapStringRepositorySaveCall {
+	return &stringByteSliceMapStringRepositorySaveCall{Call: _m.Mock.On("Save", entity), Parent: _m}
+}
+
+func (_m *stringByteSliceMapStringRepositoryMock) OnSaveRaw(entity interface{}) *stringByteSliceMapStringRepositorySaveCall {
+	return &stringByteSliceMapStringRepositorySaveCall{Call: _m.Mock.On("Save", entity), Parent: _m}
+}
+
+type stringByteSliceMapStringRepositorySaveCall struct {
+	*mock.Call
+	Parent *stringByteSliceMapStringRepositoryMock
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) Panic(msg string) *stringByteSliceMapStringRepositorySaveCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) Once() *stringByteSliceMapStringRepositorySaveCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) Twice() *stringByteSliceMapStringRepositorySaveCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) Times(i int) *stringByteSliceMapStringRepositorySaveCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) WaitUntil(w <-chan time.Time) *stringByteSliceMapStringRepositorySaveCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) After(d time.Duration) *stringByteSliceMapStringRepositorySaveCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) Run(fn func(args mock.Arguments)) *stringByteSliceMapStringRepositorySaveCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) Maybe() *stringByteSliceMapStringRepositorySaveCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) TypedReturns(a error) *stringByteSliceMapStringRepositorySaveCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) ReturnsFn(fn func(map[string][]byte) error) *stringByteSliceMapStringRepositorySaveCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) TypedRun(fn func(map[string][]byte)) *stringByteSliceMapStringRepositorySaveCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_entity, _ := args.Get(0).(map[string][]byte)
+		fn(_entity)
+	})
+	return _c
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) OnGet(id string) *stringByteSliceMapStringRepositoryGetCall {
+	return _c.Parent.OnGet(id)
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) OnList() *stringByteSliceMapStringRepositoryListCall {
+	return _c.Parent.OnList()
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) OnSave(entity map[string][]byte) *stringByteSliceMapStringRepositorySaveCall {
+	return _c.Parent.OnSave(entity)
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) OnGetRaw(id interface{}) *stringByteSliceMapStringRepositoryGetCall {
+	return _c.Parent.OnGetRaw(id)
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) OnListRaw() *stringByteSliceMapStringRepositoryListCall {
+	return _c.Parent.OnListRaw()
+}
+
+func (_c *stringByteSliceMapStringRepositorySaveCall) OnSaveRaw(entity interface{}) *stringByteSliceMapStringRepositorySaveCall {
+	return _c.Parent.OnSaveRaw(entity)
+}
+
+// userMapperMock mock of UserMapper.
+type userMapperMock struct{ mock.Mock }
+
+// newUserMapperMock creates a new userMapperMock.
+func newUserMapperMock(tb testing.TB) *userMapperMock {
+	tb.Helper()
+
+	m := &userMapperMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+// Func returns a fn2.Mapper[User] calling the mock.
+func (_m *userMapperMock) Func() fn2.Mapper[User] {
+	return _m.Execute
+}
+
+func (_m *userMapperMock) Execute(aParam User) string {
+	_ret := _m.Called(aParam)
+
+	if _rf, ok := _ret.Get(0).(func(User) string); ok {
+		return _rf(aParam)
+	}
+
+	_ra0 := _ret.String(0)
+
+	return _ra0
+}
+
+func (_m *userMapperMock) OnExecute(aParam User) *userMapperExecuteCall {
+	return &userMapperExecuteCall{Call: _m.Mock.On("Execute", aParam), Parent: _m}
+}
+
+func (_m *userMapperMock) OnExecuteRaw(aParam interface{}) *userMapperExecuteCall {
+	return &userMapperExecuteCall{Call: _m.Mock.On("Execute", aParam), Parent: _m}
+}
+
+type userMapperExecuteCall struct {
+	*mock.Call
+	Parent *userMapperMock
+}
+
+func (_c *userMapperExecuteCall) Panic(msg string) *userMapperExecuteCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *userMapperExecuteCall) Once() *userMapperExecuteCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *userMapperExecuteCall) Twice() *userMapperExecuteCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *userMapperExecuteCall) Times(i int) *userMapperExecuteCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *userMapperExecuteCall) WaitUntil(w <-chan time.Time) *userMapperExecuteCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *userMapperExecuteCall) After(d time.Duration) *userMapperExecuteCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *userMapperExecuteCall) Run(fn func(args mock.Arguments)) *userMapperExecuteCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *userMapperExecuteCall) Maybe() *userMapperExecuteCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *userMapperExecuteCall) TypedReturns(a string) *userMapperExecuteCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *userMapperExecuteCall) ReturnsFn(fn func(User) string) *userMapperExecuteCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *userMapperExecuteCall) TypedRun(fn func(User)) *userMapperExecuteCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_aParam, _ := args.Get(0).(User)
+		fn(_aParam)
+	})
+	return _c
+}
+
+func (_c *userMapperExecuteCall) OnExecute(aParam User) *userMapperExecuteCall {
+	return _c.Parent.OnExecute(aParam)
+}
+
+func (_c *userMapperExecuteCall) OnExecuteRaw(aParam interface{}) *userMapperExecuteCall {
+	return _c.Parent.OnExecuteRaw(aParam)
+}
+
+// bufferPtrSliceMapperMock mock of BufferPtrSliceMapper.
+type bufferPtrSliceMapperMock struct{ mock.Mock }
+
+// newBufferPtrSliceMapperMock creates a new bufferPtrSliceMapperMock.
+func newBufferPtrSliceMapperMock(tb testing.TB) *bufferPtrSliceMapperMock {
+	tb.Helper()
+
+	m := &bufferPtrSliceMapperMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+// Func returns a fn2.Mapper[[]*bytes.Buffer] calling the mock.
+func (_m *bufferPtrSliceMapperMock) Func() fn2.Mapper[[]*bytes.Buffer] {
+	return _m.Execute
+}
+
+func (_m *bufferPtrSliceMapperMock) Execute(aParam []*bytes.Buffer) string {
+	_ret := _m.Called(aParam)
+
+	if _rf, ok := _ret.Get(0).(func([]*bytes.Buffer) string); ok {
+		return _rf(aParam)
+	}
+
+	_ra0 := _ret.String(0)
+
+	return _ra0
+}
+
+func (_m *bufferPtrSliceMapperMock) OnExecute(aParam []*bytes.Buffer) *bufferPtrSliceMapperExecuteCall {
+	return &bufferPtrSliceMapperExecuteCall{Call: _m.Mock.On("Execute", aParam), Parent: _m}
+}
+
+func (_m *bufferPtrSliceMapperMock) OnExecuteRaw(aParam interface{}) *bufferPtrSliceMapperExecuteCall {
+	return &bufferPtrSliceMapperExecuteCall{Call: _m.Mock.On("Execute", aParam), Parent: _m}
+}
+
+type bufferPtrSliceMapperExecuteCall struct {
+	*mock.Call
+	Parent *bufferPtrSliceMapperMock
+}
+
+func (_c *bufferPtrSliceMapperExecuteCall) Panic(msg string) *bufferPtrSliceMapperExecuteCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *bufferPtrSliceMapperExecuteCall) Once() *bufferPtrSliceMapperExecuteCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *bufferPtrSliceMapperExecuteCall) Twice() *bufferPtrSliceMapperExecuteCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *bufferPtrSliceMapperExecuteCall) Times(i int) *bufferPtrSliceMapperExecuteCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *bufferPtrSliceMapperExecuteCall) WaitUntil(w <-chan time.Time) *bufferPtrSliceMapperExecuteCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *bufferPtrSliceMapperExecuteCall) After(d time.Duration) *bufferPtrSliceMapperExecuteCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *bufferPtrSliceMapperExecuteCall) Run(fn func(args mock.Arguments)) *bufferPtrSliceMapperExecuteCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *bufferPtrSliceMapperExecuteCall) Maybe() *bufferPtrSliceMapperExecuteCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *bufferPtrSliceMapperExecuteCall) TypedReturns(a string) *bufferPtrSliceMapperExecuteCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *bufferPtrSliceMapperExecuteCall) ReturnsFn(fn func([]*bytes.Buffer) string) *bufferPtrSliceMapperExecuteCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *bufferPtrSliceMapperExecuteCall) TypedRun(fn func([]*bytes.Buffer)) *bufferPtrSliceMapperExecuteCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_aParam, _ := args.Get(0).([]*bytes.Buffer)
+		fn(_aParam)
+	})
+	return _c
+}
+
+func (_c *bufferPtrSliceMapperExecuteCall) OnExecute(aParam []*bytes.Buffer) *bufferPtrSliceMapperExecuteCall {
+	return _c.Parent.OnExecute(aParam)
+}
+
+func (_c *bufferPtrSliceMapperExecuteCall) OnExecuteRaw(aParam interface{}) *bufferPtrSliceMapperExecuteCall {
+	return _c.Parent.OnExecuteRaw(aParam)
+}
@@ -0,0 +1,50 @@
+package generic
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"b/fn"
+	"b/store"
+)
+
+// mocktail:Repository[User, int]
+// mocktail:Repository[store.User, string] name=storeUserRepositoryMock constructor=newStoreUserRepositoryMock
+// mocktail:Repository[map[string][]byte, string]
+// mocktail:fn.Mapper[User]
+// mocktail:fn.Mapper[[]*bytes.Buffer]
+
+func TestRepository(t *testing.T) {
+	ctx := context.Background()
+
+	var users Repository[User, int] = newUserIntRepositoryMock(t).
+		OnGet(1).TypedReturns(User{Name: "bob"}, nil).Once().
+		Parent
+
+	_, _ = users.Get(ctx, 1)
+
+	var storeUsers Repository[store.User, string] = newStoreUserRepositoryMock(t).
+		OnSave(store.User{Name: "bob"}).TypedReturns(nil).Once().
+		Parent
+
+	_ = storeUsers.Save(ctx, store.User{Name: "bob"})
+
+	var values Repository[map[string][]byte, string] = newStringByteSliceMapStringRepositoryMock(t).
+		OnList().TypedReturns(nil, nil).Once().
+		Parent
+
+	_, _ = values.List(ctx)
+
+	var mapper fn.Mapper[User] = newUserMapperMock(t).
+		OnExecute(User{Name: "bob"}).TypedReturns("bob").Once().
+		Parent.Func()
+
+	mapper(User{Name: "bob"})
+
+	var buffers fn.Mapper[[]*bytes.Buffer] = newBufferPtrSliceMapperMock(t).
+		OnExecute(nil).TypedReturns("").Once().
+		Parent.Func()
+
+	buffers(nil)
+}
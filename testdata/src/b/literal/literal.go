@@ -0,0 +1,23 @@
+package literal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"b/store"
+)
+
+type Publisher interface {
+	Publish(ctx context.Context, event struct {
+		store.User
+		At   time.Time `json:"at"`
+		Kind string    `json:"kind,omitempty"`
+	}) error
+	Subscribe(handler interface {
+		fmt.Stringer
+		Handle(event io.Reader, opts ...time.Duration) (bool, error)
+	}) (stop func())
+	Stats() struct{}
+}
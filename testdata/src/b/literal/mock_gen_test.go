@@ -0,0 +1,412 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package literal
+
+import (
+	"b/store"
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// publisherMock mock of Publisher.
+type publisherMock struct{ mock.Mock }
+
+// newPublisherMock creates a new publisherMock.
+func newPublisherMock(tb testing.TB) *publisherMock {
+	tb.Helper()
+
+	m := &publisherMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *publisherMock) Publish(_ context.Context, event struct {
+	store.User
+	At   time.Time `json:"at"`
+	Kind string    `json:"kind,omitempty"`
+}) error {
+	_ret := _m.Called(event)
+
+	if _rf, ok := _ret.Get(0).(func(struct {
+		store.User
+		At   time.Time `json:"at"`
+		Kind string    `json:"kind,omitempty"`
+	}) error); ok {
+		return _rf(event)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *publisherMock) OnPublish(event struct {
+	store.User
+	At   time.Time `json:"at"`
+	Kind string    `json:"kind,omitempty"`
+}) *publisherPublishCall {
+	return &publisherPublishCall{Call: _m.Mock.On("Publish", event), Parent: _m}
+}
+
+func (_m *publisherMock) OnPublishRaw(event interface{}) *publisherPublishCall {
+	return &publisherPublishCall{Call: _m.Mock.On("Publish", event), Parent: _m}
+}
+
+type publisherPublishCall struct {
+	*mock.Call
+	Parent *publisherMock
+}
+
+func (_c *publisherPublishCall) Panic(msg string) *publisherPublishCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *publisherPublishCall) Once() *publisherPublishCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *publisherPublishCall) Twice() *publisherPublishCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *publisherPublishCall) Times(i int) *publisherPublishCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *publisherPublishCall) WaitUntil(w <-chan time.Time) *publisherPublishCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *publisherPublishCall) After(d time.Duration) *publisherPublishCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *publisherPublishCall) Run(fn func(args mock.Arguments)) *publisherPublishCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *publisherPublishCall) Maybe() *publisherPublishCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *publisherPublishCall) TypedReturns(a error) *publisherPublishCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *publisherPublishCall) ReturnsFn(fn func(struct {
+	store.User
+	At   time.Time `json:"at"`
+	Kind string    `json:"kind,omitempty"`
+}) error) *publisherPublishCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *publisherPublishCall) TypedRun(fn func(struct {
+	store.User
+	At   time.Time `json:"at"`
+	Kind string    `json:"kind,omitempty"`
+})) *publisherPublishCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_event, _ := args.Get(0).(struct {
+			store.User
+			At   time.Time `json:"at"`
+			Kind string    `json:"kind,omitempty"`
+		})
+		fn(_event)
+	})
+	return _c
+}
+
+func (_c *publisherPublishCall) OnPublish(event struct {
+	store.User
+	At   time.Time `json:"at"`
+	Kind string    `json:"kind,omitempty"`
+}) *publisherPublishCall {
+	return _c.Parent.OnPublish(event)
+}
+
+func (_c *publisherPublishCall) OnStats() *publisherStatsCall {
+	return _c.Parent.OnStats()
+}
+
+func (_c *publisherPublishCall) OnSubscribe(handler interface {
+	fmt.Stringer
+	Handle(io.Reader, ...time.Duration) (bool, error)
+}) *publisherSubscribeCall {
+	return _c.Parent.OnSubscribe(handler)
+}
+
+func (_c *publisherPublishCall) OnPublishRaw(event interface{}) *publisherPublishCall {
+	return _c.Parent.OnPublishRaw(event)
+}
+
+func (_c *publisherPublishCall) OnStatsRaw() *publisherStatsCall {
+	return _c.Parent.OnStatsRaw()
+}
+
+func (_c *publisherPublishCall) OnSubscribeRaw(handler interface{}) *publisherSubscribeCall {
+	return _c.Parent.OnSubscribeRaw(handler)
+}
+
+func (_m *publisherMock) Stats() struct{} {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() struct{}); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(struct{})
+
+	return _ra0
+}
+
+func (_m *publisherMock) OnStats() *publisherStatsCall {
+	return &publisherStatsCall{Call: _m.Mock.On("Stats"), Parent: _m}
+}
+
+func (_m *publisherMock) OnStatsRaw() *publisherStatsCall {
+	return &publisherStatsCall{Call: _m.Mock.On("Stats"), Parent: _m}
+}
+
+type publisherStatsCall struct {
+	*mock.Call
+	Parent *publisherMock
+}
+
+func (_c *publisherStatsCall) Panic(msg string) *publisherStatsCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *publisherStatsCall) Once() *publisherStatsCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *publisherStatsCall) Twice() *publisherStatsCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *publisherStatsCall) Times(i int) *publisherStatsCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *publisherStatsCall) WaitUntil(w <-chan time.Time) *publisherStatsCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *publisherStatsCall) After(d time.Duration) *publisherStatsCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *publisherStatsCall) Run(fn func(args mock.Arguments)) *publisherStatsCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *publisherStatsCall) Maybe() *publisherStatsCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *publisherStatsCall) TypedReturns(a struct{}) *publisherStatsCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *publisherStatsCall) ReturnsFn(fn func() struct{}) *publisherStatsCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *publisherStatsCall) TypedRun(fn func()) *publisherStatsCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *publisherStatsCall) OnPublish(event struct {
+	store.User
+	At   time.Time `json:"at"`
+	Kind string    `json:"kind,omitempty"`
+}) *publisherPublishCall {
+	return _c.Parent.OnPublish(event)
+}
+
+func (_c *publisherStatsCall) OnStats() *publisherStatsCall {
+	return _c.Parent.OnStats()
+}
+
+func (_c *publisherStatsCall) OnSubscribe(handler interface {
+	fmt.Stringer
+	Handle(io.Reader, ...time.Duration) (bool, error)
+}) *publisherSubscribeCall {
+	return _c.Parent.OnSubscribe(handler)
+}
+
+func (_c *publisherStatsCall) OnPublishRaw(event interface{}) *publisherPublishCall {
+	return _c.Parent.OnPublishRaw(event)
+}
+
+func (_c *publisherStatsCall) OnStatsRaw() *publisherStatsCall {
+	return _c.Parent.OnStatsRaw()
+}
+
+func (_c *publisherStatsCall) OnSubscribeRaw(handler interface{}) *publisherSubscribeCall {
+	return _c.Parent.OnSubscribeRaw(handler)
+}
+
+func (_m *publisherMock) Subscribe(handler interface {
+	fmt.Stringer
+	Handle(io.Reader, ...time.Duration) (bool, error)
+}) func() {
+	_ret := _m.Called(handler)
+
+	if _rf, ok := _ret.Get(0).(func(interface {
+		fmt.Stringer
+		Handle(io.Reader, ...time.Duration) (bool, error)
+	}) func()); ok {
+		return _rf(handler)
+	}
+
+	stop, _ := _ret.Get(0).(func())
+
+	return stop
+}
+
+func (_m *publisherMock) OnSubscribe(handler interface {
+	fmt.Stringer
+	Handle(io.Reader, ...time.Duration) (bool, error)
+}) *publisherSubscribeCall {
+	return &publisherSubscribeCall{Call: _m.Mock.On("Subscribe", handler), Parent: _m}
+}
+
+func (_m *publisherMock) OnSubscribeRaw(handler interface{}) *publisherSubscribeCall {
+	return &publisherSubscribeCall{Call: _m.Mock.On("Subscribe", handler), Parent: _m}
+}
+
+type publisherSubscribeCall struct {
+	*mock.Call
+	Parent *publisherMock
+}
+
+func (_c *publisherSubscribeCall) Panic(msg string) *publisherSubscribeCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *publisherSubscribeCall) Once() *publisherSubscribeCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *publisherSubscribeCall) Twice() *publisherSubscribeCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *publisherSubscribeCall) Times(i int) *publisherSubscribeCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *publisherSubscribeCall) WaitUntil(w <-chan time.Time) *publisherSubscribeCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *publisherSubscribeCall) After(d time.Duration) *publisherSubscribeCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *publisherSubscribeCall) Run(fn func(args mock.Arguments)) *publisherSubscribeCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *publisherSubscribeCall) Maybe() *publisherSubscribeCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *publisherSubscribeCall) TypedReturns(a func()) *publisherSubscribeCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *publisherSubscribeCall) ReturnsFn(fn func(interface {
+	fmt.Stringer
+	Handle(io.Reader, ...time.Duration) (bool, error)
+}) func()) *publisherSubscribeCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *publisherSubscribeCall) TypedRun(fn func(interface {
+	fmt.Stringer
+	Handle(io.Reader, ...time.Duration) (bool, error)
+})) *publisherSubscribeCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_handler, _ := args.Get(0).(interface {
+			fmt.Stringer
+			Handle(io.Reader, ...time.Duration) (bool, error)
+		})
+		fn(_handler)
+	})
+	return _c
+}
+
+func (_c *publisherSubscribeCall) OnPublish(event struct {
+	store.User
+	At   time.Time `json:"at"`
+	Kind string    `json:"kind,omitempty"`
+}) *publisherPublishCall {
+	return _c.Parent.OnPublish(event)
+}
+
+func (_c *publisherSubscribeCall) OnStats() *publisherStatsCall {
+	return _c.Parent.OnStats()
+}
+
+func (_c *publisherSubscribeCall) OnSubscribe(handler interface {
+	fmt.Stringer
+	Handle(io.Reader, ...time.Duration) (bool, error)
+}) *publisherSubscribeCall {
+	return _c.Parent.OnSubscribe(handler)
+}
+
+func (_c *publisherSubscribeCall) OnPublishRaw(event interface{}) *publisherPublishCall {
+	return _c.Parent.OnPublishRaw(event)
+}
+
+func (_c *publisherSubscribeCall) OnStatsRaw() *publisherStatsCall {
+	return _c.Parent.OnStatsRaw()
+}
+
+func (_c *publisherSubscribeCall) OnSubscribeRaw(handler interface{}) *publisherSubscribeCall {
+	return _c.Parent.OnSubscribeRaw(handler)
+}
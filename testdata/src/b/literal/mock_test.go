@@ -0,0 +1,25 @@
+package literal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"b/store"
+)
+
+// mocktail:Publisher
+
+func TestPublisher(t *testing.T) {
+	event := struct {
+		store.User
+		At   time.Time `json:"at"`
+		Kind string    `json:"kind,omitempty"`
+	}{Kind: "created"}
+
+	var publisher Publisher = newPublisherMock(t).
+		OnPublish(event).TypedReturns(nil).Once().
+		Parent
+
+	_ = publisher.Publish(context.Background(), event)
+}
@@ -0,0 +1,227 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package client
+
+import (
+	"b/paging"
+	"b/store"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// finderMock mock of Finder.
+type finderMock struct{ mock.Mock }
+
+// newFinderMock creates a new finderMock.
+func newFinderMock(tb testing.TB) *finderMock {
+	tb.Helper()
+
+	m := &finderMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *finderMock) Find(_ context.Context, q paging.Query[store.User]) (paging.Page[store.User], error) {
+	_ret := _m.Called(q)
+
+	if _rf, ok := _ret.Get(0).(func(paging.Query[store.User]) (paging.Page[store.User], error)); ok {
+		return _rf(q)
+	}
+
+	_ra0, _ := _ret.Get(0).(paging.Page[store.User])
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *finderMock) OnFind(q paging.Query[store.User]) *finderFindCall {
+	return &finderFindCall{Call: _m.Mock.On("Find", q), Parent: _m}
+}
+
+func (_m *finderMock) OnFindRaw(q interface{}) *finderFindCall {
+	return &finderFindCall{Call: _m.Mock.On("Find", q), Parent: _m}
+}
+
+type finderFindCall struct {
+	*mock.Call
+	Parent *finderMock
+}
+
+func (_c *finderFindCall) Panic(msg string) *finderFindCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *finderFindCall) Once() *finderFindCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *finderFindCall) Twice() *finderFindCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *finderFindCall) Times(i int) *finderFindCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *finderFindCall) WaitUntil(w <-chan time.Time) *finderFindCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *finderFindCall) After(d time.Duration) *finderFindCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *finderFindCall) Run(fn func(args mock.Arguments)) *finderFindCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *finderFindCall) Maybe() *finderFindCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *finderFindCall) TypedReturns(a paging.Page[store.User], b error) *finderFindCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *finderFindCall) ReturnsFn(fn func(paging.Query[store.User]) (paging.Page[store.User], error)) *finderFindCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *finderFindCall) TypedRun(fn func(paging.Query[store.User])) *finderFindCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_q, _ := args.Get(0).(paging.Query[store.User])
+		fn(_q)
+	})
+	return _c
+}
+
+func (_c *finderFindCall) OnFind(q paging.Query[store.User]) *finderFindCall {
+	return _c.Parent.OnFind(q)
+}
+
+func (_c *finderFindCall) OnPairs(in []paging.Pair[string, paging.Page[int]]) *finderPairsCall {
+	return _c.Parent.OnPairs(in)
+}
+
+func (_c *finderFindCall) OnFindRaw(q interface{}) *finderFindCall {
+	return _c.Parent.OnFindRaw(q)
+}
+
+func (_c *finderFindCall) OnPairsRaw(in interface{}) *finderPairsCall {
+	return _c.Parent.OnPairsRaw(in)
+}
+
+func (_m *finderMock) Pairs(in []paging.Pair[string, paging.Page[int]]) map[string]paging.Query[*store.User] {
+	_ret := _m.Called(in)
+
+	if _rf, ok := _ret.Get(0).(func([]paging.Pair[string, paging.Page[int]]) map[string]paging.Query[*store.User]); ok {
+		return _rf(in)
+	}
+
+	_ra0, _ := _ret.Get(0).(map[string]paging.Query[*store.User])
+
+	return _ra0
+}
+
+func (_m *finderMock) OnPairs(in []paging.Pair[string, paging.Page[int]]) *finderPairsCall {
+	return &finderPairsCall{Call: _m.Mock.On("Pairs", in), Parent: _m}
+}
+
+func (_m *finderMock) OnPairsRaw(in interface{}) *finderPairsCall {
+	return &finderPairsCall{Call: _m.Mock.On("Pairs", in), Parent: _m}
+}
+
+type finderPairsCall struct {
+	*mock.Call
+	Parent *finderMock
+}
+
+func (_c *finderPairsCall) Panic(msg string) *finderPairsCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *finderPairsCall) Once() *finderPairsCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *finderPairsCall) Twice() *finderPairsCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *finderPairsCall) Times(i int) *finderPairsCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *finderPairsCall) WaitUntil(w <-chan time.Time) *finderPairsCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *finderPairsCall) After(d time.Duration) *finderPairsCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *finderPairsCall) Run(fn func(args mock.Arguments)) *finderPairsCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *finderPairsCall) Maybe() *finderPairsCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *finderPairsCall) TypedReturns(a map[string]paging.Query[*store.User]) *finderPairsCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *finderPairsCall) ReturnsFn(fn func([]paging.Pair[string, paging.Page[int]]) map[string]paging.Query[*store.User]) *finderPairsCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *finderPairsCall) TypedRun(fn func([]paging.Pair[string, paging.Page[int]])) *finderPairsCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_in, _ := args.Get(0).([]paging.Pair[string, paging.Page[int]])
+		fn(_in)
+	})
+	return _c
+}
+
+func (_c *finderPairsCall) OnFind(q paging.Query[store.User]) *finderFindCall {
+	return _c.Parent.OnFind(q)
+}
+
+func (_c *finderPairsCall) OnPairs(in []paging.Pair[string, paging.Page[int]]) *finderPairsCall {
+	return _c.Parent.OnPairs(in)
+}
+
+func (_c *finderPairsCall) OnFindRaw(q interface{}) *finderFindCall {
+	return _c.Parent.OnFindRaw(q)
+}
+
+func (_c *finderPairsCall) OnPairsRaw(in interface{}) *finderPairsCall {
+	return _c.Parent.OnPairsRaw(in)
+}
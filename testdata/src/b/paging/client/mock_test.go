@@ -0,0 +1,19 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"b/paging"
+	"b/store"
+)
+
+// mocktail:paging.Finder
+
+func TestFinder(t *testing.T) {
+	var finder paging.Finder = newFinderMock(t).
+		OnFind(paging.Query[store.User]{}).TypedReturns(paging.Page[store.User]{}, nil).Once().
+		Parent
+
+	_, _ = finder.Find(context.Background(), paging.Query[store.User]{})
+}
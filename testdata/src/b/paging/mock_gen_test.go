@@ -0,0 +1,766 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package paging
+
+import (
+	"b/store"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// finderMock mock of Finder.
+type finderMock struct{ mock.Mock }
+
+// newFinderMock creates a new finderMock.
+func newFinderMock(tb testing.TB) *finderMock {
+	tb.Helper()
+
+	m := &finderMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *finderMock) Find(_ context.Context, q Query[store.User]) (Page[store.User], error) {
+	_ret := _m.Called(q)
+
+	if _rf, ok := _ret.Get(0).(func(Query[store.User]) (Page[store.User], error)); ok {
+		return _rf(q)
+	}
+
+	_ra0, _ := _ret.Get(0).(Page[store.User])
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *finderMock) OnFind(q Query[store.User]) *finderFindCall {
+	return &finderFindCall{Call: _m.Mock.On("Find", q), Parent: _m}
+}
+
+func (_m *finderMock) OnFindRaw(q interface{}) *finderFindCall {
+	return &finderFindCall{Call: _m.Mock.On("Find", q), Parent: _m}
+}
+
+type finderFindCall struct {
+	*mock.Call
+	Parent *finderMock
+}
+
+func (_c *finderFindCall) Panic(msg string) *finderFindCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *finderFindCall) Once() *finderFindCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *finderFindCall) Twice() *finderFindCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *finderFindCall) Times(i int) *finderFindCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *finderFindCall) WaitUntil(w <-chan time.Time) *finderFindCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *finderFindCall) After(d time.Duration) *finderFindCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *finderFindCall) Run(fn func(args mock.Arguments)) *finderFindCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *finderFindCall) Maybe() *finderFindCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *finderFindCall) TypedReturns(a Page[store.User], b error) *finderFindCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *finderFindCall) ReturnsFn(fn func(Query[store.User]) (Page[store.User], error)) *finderFindCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *finderFindCall) TypedRun(fn func(Query[store.User])) *finderFindCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_q, _ := args.Get(0).(Query[store.User])
+		fn(_q)
+	})
+	return _c
+}
+
+func (_c *finderFindCall) OnFind(q Query[store.User]) *finderFindCall {
+	return _c.Parent.OnFind(q)
+}
+
+func (_c *finderFindCall) OnPairs(in []Pair[string, Page[int]]) *finderPairsCall {
+	return _c.Parent.OnPairs(in)
+}
+
+func (_c *finderFindCall) OnFindRaw(q interface{}) *finderFindCall {
+	return _c.Parent.OnFindRaw(q)
+}
+
+func (_c *finderFindCall) OnPairsRaw(in interface{}) *finderPairsCall {
+	return _c.Parent.OnPairsRaw(in)
+}
+
+func (_m *finderMock) Pairs(in []Pair[string, Page[int]]) map[string]Query[*store.User] {
+	_ret := _m.Called(in)
+
+	if _rf, ok := _ret.Get(0).(func([]Pair[string, Page[int]]) map[string]Query[*store.User]); ok {
+		return _rf(in)
+	}
+
+	_ra0, _ := _ret.Get(0).(map[string]Query[*store.User])
+
+	return _ra0
+}
+
+func (_m *finderMock) OnPairs(in []Pair[string, Page[int]]) *finderPairsCall {
+	return &finderPairsCall{Call: _m.Mock.On("Pairs", in), Parent: _m}
+}
+
+func (_m *finderMock) OnPairsRaw(in interface{}) *finderPairsCall {
+	return &finderPairsCall{Call: _m.Mock.On("Pairs", in), Parent: _m}
+}
+
+type finderPairsCall struct {
+	*mock.Call
+	Parent *finderMock
+}
+
+func (_c *finderPairsCall) Panic(msg string) *finderPairsCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *finderPairsCall) Once() *finderPairsCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *finderPairsCall) Twice() *finderPairsCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *finderPairsCall) Times(i int) *finderPairsCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *finderPairsCall) WaitUntil(w <-chan time.Time) *finderPairsCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *finderPairsCall) After(d time.Duration) *finderPairsCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *finderPairsCall) Run(fn func(args mock.Arguments)) *finderPairsCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *finderPairsCall) Maybe() *finderPairsCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *finderPairsCall) TypedReturns(a map[string]Query[*store.User]) *finderPairsCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *finderPairsCall) ReturnsFn(fn func([]Pair[string, Page[int]]) map[string]Query[*store.User]) *finderPairsCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *finderPairsCall) TypedRun(fn func([]Pair[string, Page[int]])) *finderPairsCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_in, _ := args.Get(0).([]Pair[string, Page[int]])
+		fn(_in)
+	})
+	return _c
+}
+
+func (_c *finderPairsCall) OnFind(q Query[store.User]) *finderFindCall {
+	return _c.Parent.OnFind(q)
+}
+
+func (_c *finderPairsCall) OnPairs(in []Pair[string, Page[int]]) *finderPairsCall {
+	return _c.Parent.OnPairs(in)
+}
+
+func (_c *finderPairsCall) OnFindRaw(q interface{}) *finderFindCall {
+	return _c.Parent.OnFindRaw(q)
+}
+
+func (_c *finderPairsCall) OnPairsRaw(in interface{}) *finderPairsCall {
+	return _c.Parent.OnPairsRaw(in)
+}
+
+// repositoryMock mock of Repository.
+type repositoryMock[T any] struct{ mock.Mock }
+
+// newRepositoryMock creates a new repositoryMock.
+func newRepositoryMock[T any](tb testing.TB) *repositoryMock[T] {
+	tb.Helper()
+
+	m := &repositoryMock[T]{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *repositoryMock[T]) List(q Query[T]) (Page[T], error) {
+	_ret := _m.Called(q)
+
+	if _rf, ok := _ret.Get(0).(func(Query[T]) (Page[T], error)); ok {
+		return _rf(q)
+	}
+
+	_ra0, _ := _ret.Get(0).(Page[T])
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *repositoryMock[T]) OnList(q Query[T]) *repositoryListCall[T] {
+	return &repositoryListCall[T]{Call: _m.Mock.On("List", q), Parent: _m}
+}
+
+func (_m *repositoryMock[T]) OnListRaw(q interface{}) *repositoryListCall[T] {
+	return &repositoryListCall[T]{Call: _m.Mock.On("List", q), Parent: _m}
+}
+
+type repositoryListCall[T any] struct {
+	*mock.Call
+	Parent *repositoryMock[T]
+}
+
+func (_c *repositoryListCall[T]) Panic(msg string) *repositoryListCall[T] {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *repositoryListCall[T]) Once() *repositoryListCall[T] {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *repositoryListCall[T]) Twice() *repositoryListCall[T] {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *repositoryListCall[T]) Times(i int) *repositoryListCall[T] {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *repositoryListCall[T]) WaitUntil(w <-chan time.Time) *repositoryListCall[T] {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *repositoryListCall[T]) After(d time.Duration) *repositoryListCall[T] {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *repositoryListCall[T]) Run(fn func(args mock.Arguments)) *repositoryListCall[T] {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *repositoryListCall[T]) Maybe() *repositoryListCall[T] {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *repositoryListCall[T]) TypedReturns(a Page[T], b error) *repositoryListCall[T] {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *repositoryListCall[T]) ReturnsFn(fn func(Query[T]) (Page[T], error)) *repositoryListCall[T] {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *repositoryListCall[T]) TypedRun(fn func(Query[T])) *repositoryListCall[T] {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_q, _ := args.Get(0).(Query[T])
+		fn(_q)
+	})
+	return _c
+}
+
+func (_c *repositoryListCall[T]) OnList(q Query[T]) *repositoryListCall[T] {
+	return _c.Parent.OnList(q)
+}
+
+func (_c *repositoryListCall[T]) OnNested(p Page[Query[T]]) *repositoryNestedCall[T] {
+	return _c.Parent.OnNested(p)
+}
+
+func (_c *repositoryListCall[T]) OnListRaw(q interface{}) *repositoryListCall[T] {
+	return _c.Parent.OnListRaw(q)
+}
+
+func (_c *repositoryListCall[T]) OnNestedRaw(p interface{}) *repositoryNestedCall[T] {
+	return _c.Parent.OnNestedRaw(p)
+}
+
+func (_m *repositoryMock[T]) Nested(p Page[Query[T]]) Pair[string, T] {
+	_ret := _m.Called(p)
+
+	if _rf, ok := _ret.Get(0).(func(Page[Query[T]]) Pair[string, T]); ok {
+		return _rf(p)
+	}
+
+	_ra0, _ := _ret.Get(0).(Pair[string, T])
+
+	return _ra0
+}
+
+func (_m *repositoryMock[T]) OnNested(p Page[Query[T]]) *repositoryNestedCall[T] {
+	return &repositoryNestedCall[T]{Call: _m.Mock.On("Nested", p), Parent: _m}
+}
+
+func (_m *repositoryMock[T]) OnNestedRaw(p interface{}) *repositoryNestedCall[T] {
+	return &repositoryNestedCall[T]{Call: _m.Mock.On("Nested", p), Parent: _m}
+}
+
+type repositoryNestedCall[T any] struct {
+	*mock.Call
+	Parent *repositoryMock[T]
+}
+
+func (_c *repositoryNestedCall[T]) Panic(msg string) *repositoryNestedCall[T] {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *repositoryNestedCall[T]) Once() *repositoryNestedCall[T] {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *repositoryNestedCall[T]) Twice() *repositoryNestedCall[T] {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *repositoryNestedCall[T]) Times(i int) *repositoryNestedCall[T] {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *repositoryNestedCall[T]) WaitUntil(w <-chan time.Time) *repositoryNestedCall[T] {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *repositoryNestedCall[T]) After(d time.Duration) *repositoryNestedCall[T] {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *repositoryNestedCall[T]) Run(fn func(args mock.Arguments)) *repositoryNestedCall[T] {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *repositoryNestedCall[T]) Maybe() *repositoryNestedCall[T] {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *repositoryNestedCall[T]) TypedReturns(a Pair[string, T]) *repositoryNestedCall[T] {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *repositoryNestedCall[T]) ReturnsFn(fn func(Page[Query[T]]) Pair[string, T]) *repositoryNestedCall[T] {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *repositoryNestedCall[T]) TypedRun(fn func(Page[Query[T]])) *repositoryNestedCall[T] {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_p, _ := args.Get(0).(Page[Query[T]])
+		fn(_p)
+	})
+	return _c
+}
+
+func (_c *repositoryNestedCall[T]) OnList(q Query[T]) *repositoryListCall[T] {
+	return _c.Parent.OnList(q)
+}
+
+func (_c *repositoryNestedCall[T]) OnNested(p Page[Query[T]]) *repositoryNestedCall[T] {
+	return _c.Parent.OnNested(p)
+}
+
+func (_c *repositoryNestedCall[T]) OnListRaw(q interface{}) *repositoryListCall[T] {
+	return _c.Parent.OnListRaw(q)
+}
+
+func (_c *repositoryNestedCall[T]) OnNestedRaw(p interface{}) *repositoryNestedCall[T] {
+	return _c.Parent.OnNestedRaw(p)
+}
+
+// userRepositoryMock mock of UserRepository.
+type userRepositoryMock struct{ mock.Mock }
+
+// newUserRepositoryMock creates a new userRepositoryMock.
+func newUserRepositoryMock(tb testing.TB) *userRepositoryMock {
+	tb.Helper()
+
+	m := &userRepositoryMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *userRepositoryMock) List(q Query[store.User]) (Page[store.User], error) {
+	_ret := _m.Called(q)
+
+	if _rf, ok := _ret.Get(0).(func(Query[store.User]) (Page[store.User], error)); ok {
+		return _rf(q)
+	}
+
+	_ra0, _ := _ret.Get(0).(Page[store.User])
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *userRepositoryMock) OnList(q Query[store.User]) *userRepositoryListCall {
+	return &userRepositoryListCall{Call: _m.Mock.On("List", q), Parent: _m}
+}
+
+func (_m *userRepositoryMock) OnListRaw(q interface{}) *userRepositoryListCall {
+	return &userRepositoryListCall{Call: _m.Mock.On("List", q), Parent: _m}
+}
+
+type userRepositoryListCall struct {
+	*mock.Call
+	Parent *userRepositoryMock
+}
+
+func (_c *userRepositoryListCall) Panic(msg string) *userRepositoryListCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *userRepositoryListCall) Once() *userRepositoryListCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *userRepositoryListCall) Twice() *userRepositoryListCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *userRepositoryListCall) Times(i int) *userRepositoryListCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *userRepositoryListCall) WaitUntil(w <-chan time.Time) *userRepositoryListCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *userRepositoryListCall) After(d time.Duration) *userRepositoryListCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *userRepositoryListCall) Run(fn func(args mock.Arguments)) *userRepositoryListCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *userRepositoryListCall) Maybe() *userRepositoryListCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *userRepositoryListCall) TypedReturns(a Page[store.User], b error) *userRepositoryListCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *userRepositoryListCall) ReturnsFn(fn func(Query[store.User]) (Page[store.User], error)) *userRepositoryListCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *userRepositoryListCall) TypedRun(fn func(Query[store.User])) *userRepositoryListCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_q, _ := args.Get(0).(Query[store.User])
+		fn(_q)
+	})
+	return _c
+}
+
+func (_c *userRepositoryListCall) OnList(q Query[store.User]) *userRepositoryListCall {
+	return _c.Parent.OnList(q)
+}
+
+func (_c *userRepositoryListCall) OnNested(p Page[Query[store.User]]) *userRepositoryNestedCall {
+	return _c.Parent.OnNested(p)
+}
+
+func (_c *userRepositoryListCall) OnListRaw(q interface{}) *userRepositoryListCall {
+	return _c.Parent.OnListRaw(q)
+}
+
+func (_c *userRepositoryListCall) OnNestedRaw(p interface{}) *userRepositoryNestedCall {
+	return _c.Parent.OnNestedRaw(p)
+}
+
+func (_m *userRepositoryMock) Nested(p Page[Query[store.User]]) Pair[string, store.User] {
+	_ret := _m.Called(p)
+
+	if _rf, ok := _ret.Get(0).(func(Page[Query[store.User]]) Pair[string, store.User]); ok {
+		return _rf(p)
+	}
+
+	_ra0, _ := _ret.Get(0).(Pair[string, store.User])
+
+	return _ra0
+}
+
+func (_m *userRepositoryMock) OnNested(p Page[Query[store.User]]) *userRepositoryNestedCall {
+	return &userRepositoryNestedCall{Call: _m.Mock.On("Nested", p), Parent: _m}
+}
+
+func (_m *userRepositoryMock) OnNestedRaw(p interface{}) *userRepositoryNestedCall {
+	return &userRepositoryNestedCall{Call: _m.Mock.On("Nested", p), Parent: _m}
+}
+
+type userRepositoryNestedCall struct {
+	*mock.Call
+	Parent *userRepositoryMock
+}
+
+func (_c *userRepositoryNestedCall) Panic(msg string) *userRepositoryNestedCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *userRepositoryNestedCall) Once() *userRepositoryNestedCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *userRepositoryNestedCall) Twice() *userRepositoryNestedCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *userRepositoryNestedCall) Times(i int) *userRepositoryNestedCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *userRepositoryNestedCall) WaitUntil(w <-chan time.Time) *userRepositoryNestedCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *userRepositoryNestedCall) After(d time.Duration) *userRepositoryNestedCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *userRepositoryNestedCall) Run(fn func(args mock.Arguments)) *userRepositoryNestedCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *userRepositoryNestedCall) Maybe() *userRepositoryNestedCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *userRepositoryNestedCall) TypedReturns(a Pair[string, store.User]) *userRepositoryNestedCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *userRepositoryNestedCall) ReturnsFn(fn func(Page[Query[store.User]]) Pair[string, store.User]) *userRepositoryNestedCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *userRepositoryNestedCall) TypedRun(fn func(Page[Query[store.User]])) *userRepositoryNestedCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_p, _ := args.Get(0).(Page[Query[store.User]])
+		fn(_p)
+	})
+	return _c
+}
+
+func (_c *userRepositoryNestedCall) OnList(q Query[store.User]) *userRepositoryListCall {
+	return _c.Parent.OnList(q)
+}
+
+func (_c *userRepositoryNestedCall) OnNested(p Page[Query[store.User]]) *userRepositoryNestedCall {
+	return _c.Parent.OnNested(p)
+}
+
+func (_c *userRepositoryNestedCall) OnListRaw(q interface{}) *userRepositoryListCall {
+	return _c.Parent.OnListRaw(q)
+}
+
+func (_c *userRepositoryNestedCall) OnNestedRaw(p interface{}) *userRepositoryNestedCall {
+	return _c.Parent.OnNestedRaw(p)
+}
+
+// stringLoaderMock mock of StringLoader.
+type stringLoaderMock struct{ mock.Mock }
+
+// newStringLoaderMock creates a new stringLoaderMock.
+func newStringLoaderMock(tb testing.TB) *stringLoaderMock {
+	tb.Helper()
+
+	m := &stringLoaderMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+// Func returns a Loader[string] calling the mock.
+func (_m *stringLoaderMock) Func() Loader[string] {
+	return _m.Execute
+}
+
+func (_m *stringLoaderMock) Execute(_ context.Context, c Cursor[string]) (Page[string], error) {
+	_ret := _m.Called(c)
+
+	if _rf, ok := _ret.Get(0).(func(Cursor[string]) (Page[string], error)); ok {
+		return _rf(c)
+	}
+
+	_ra0, _ := _ret.Get(0).(Page[string])
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *stringLoaderMock) OnExecute(c Cursor[string]) *stringLoaderExecuteCall {
+	return &stringLoaderExecuteCall{Call: _m.Mock.On("Execute", c), Parent: _m}
+}
+
+func (_m *stringLoaderMock) OnExecuteRaw(c interface{}) *stringLoaderExecuteCall {
+	return &stringLoaderExecuteCall{Call: _m.Mock.On("Execute", c), Parent: _m}
+}
+
+type stringLoaderExecuteCall struct {
+	*mock.Call
+	Parent *stringLoaderMock
+}
+
+func (_c *stringLoaderExecuteCall) Panic(msg string) *stringLoaderExecuteCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *stringLoaderExecuteCall) Once() *stringLoaderExecuteCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *stringLoaderExecuteCall) Twice() *stringLoaderExecuteCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *stringLoaderExecuteCall) Times(i int) *stringLoaderExecuteCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *stringLoaderExecuteCall) WaitUntil(w <-chan time.Time) *stringLoaderExecuteCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *stringLoaderExecuteCall) After(d time.Duration) *stringLoaderExecuteCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *stringLoaderExecuteCall) Run(fn func(args mock.Arguments)) *stringLoaderExecuteCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *stringLoaderExecuteCall) Maybe() *stringLoaderExecuteCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *stringLoaderExecuteCall) TypedReturns(a Page[string], b error) *stringLoaderExecuteCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *stringLoaderExecuteCall) ReturnsFn(fn func(Cursor[string]) (Page[string], error)) *stringLoaderExecuteCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *stringLoaderExecuteCall) TypedRun(fn func(Cursor[string])) *stringLoaderExecuteCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_c, _ := args.Get(0).(Cursor[string])
+		fn(_c)
+	})
+	return _c
+}
+
+func (_c *stringLoaderExecuteCall) OnExecute(c Cursor[string]) *stringLoaderExecuteCall {
+	return _c.Parent.OnExecute(c)
+}
+
+func (_c *stringLoaderExecuteCall) OnExecuteRaw(c interface{}) *stringLoaderExecuteCall {
+	return _c.Parent.OnExecuteRaw(c)
+}
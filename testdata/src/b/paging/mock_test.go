@@ -0,0 +1,43 @@
+package paging
+
+import (
+	"context"
+	"testing"
+
+	"b/store"
+)
+
+// mocktail:Finder
+// mocktail:Repository
+// mocktail:Repository[store.User]
+// mocktail:Loader[string]
+
+func TestFinder(t *testing.T) {
+	var finder Finder = newFinderMock(t).
+		OnFind(Query[store.User]{}).TypedReturns(Page[store.User]{}, nil).Once().
+		Parent
+
+	_, _ = finder.Find(context.Background(), Query[store.User]{})
+}
+
+func TestRepository(t *testing.T) {
+	var repo Repository[int] = newRepositoryMock[int](t).
+		OnList(Query[int]{Filter: 1}).TypedReturns(Page[int]{}, nil).Once().
+		Parent
+
+	_, _ = repo.List(Query[int]{Filter: 1})
+
+	var users Repository[store.User] = newUserRepositoryMock(t).
+		OnNested(Page[Query[store.User]]{}).TypedReturns(Pair[string, store.User]{}).Once().
+		Parent
+
+	_ = users.Nested(Page[Query[store.User]]{})
+}
+
+func TestLoader(t *testing.T) {
+	var loader Loader[string] = newStringLoaderMock(t).
+		OnExecute(Cursor[string]{}).TypedReturns(Page[string]{}, nil).Once().
+		Parent.Func()
+
+	_, _ = loader(context.Background(), Cursor[string]{})
+}
@@ -0,0 +1,33 @@
+package paging
+
+import (
+	"context"
+
+	"b/store"
+)
+
+type Query[T any] struct{ Filter T }
+
+type Cursor[T any] struct{ Last T }
+
+type Page[T any] struct {
+	Items []T
+	Next  *Cursor[T]
+}
+
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+type Finder interface {
+	Find(ctx context.Context, q Query[store.User]) (Page[store.User], error)
+	Pairs(in []Pair[string, Page[int]]) map[string]Query[*store.User]
+}
+
+type Repository[T any] interface {
+	List(q Query[T]) (Page[T], error)
+	Nested(p Page[Query[T]]) Pair[string, T]
+}
+
+type Loader[T any] func(ctx context.Context, c Cursor[T]) (Page[T], error)
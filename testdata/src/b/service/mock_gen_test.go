@@ -0,0 +1,337 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package service
+
+import (
+	fn2 "b/fn"
+	"b/store"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// userRepoMock mock of UserRepo.
+type userRepoMock struct{ mock.Mock }
+
+// newUserRepoMock creates a new userRepoMock.
+func newUserRepoMock(tb testing.TB) *userRepoMock {
+	tb.Helper()
+
+	m := &userRepoMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *userRepoMock) Find(_ context.Context, name string) (store.User, error) {
+	_ret := _m.Called(name)
+
+	if _rf, ok := _ret.Get(0).(func(string) (store.User, error)); ok {
+		return _rf(name)
+	}
+
+	_ra0, _ := _ret.Get(0).(store.User)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *userRepoMock) OnFind(name string) *userRepoFindCall {
+	return &userRepoFindCall{Call: _m.Mock.On("Find", name), Parent: _m}
+}
+
+func (_m *userRepoMock) OnFindRaw(name interface{}) *userRepoFindCall {
+	return &userRepoFindCall{Call: _m.Mock.On("Find", name), Parent: _m}
+}
+
+type userRepoFindCall struct {
+	*mock.Call
+	Parent *userRepoMock
+}
+
+func (_c *userRepoFindCall) Panic(msg string) *userRepoFindCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *userRepoFindCall) Once() *userRepoFindCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *userRepoFindCall) Twice() *userRepoFindCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *userRepoFindCall) Times(i int) *userRepoFindCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *userRepoFindCall) WaitUntil(w <-chan time.Time) *userRepoFindCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *userRepoFindCall) After(d time.Duration) *userRepoFindCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *userRepoFindCall) Run(fn func(args mock.Arguments)) *userRepoFindCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *userRepoFindCall) Maybe() *userRepoFindCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *userRepoFindCall) TypedReturns(a store.User, b error) *userRepoFindCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *userRepoFindCall) ReturnsFn(fn func(string) (store.User, error)) *userRepoFindCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *userRepoFindCall) TypedRun(fn func(string)) *userRepoFindCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_name := args.String(0)
+		fn(_name)
+	})
+	return _c
+}
+
+func (_c *userRepoFindCall) OnFind(name string) *userRepoFindCall {
+	return _c.Parent.OnFind(name)
+}
+
+func (_c *userRepoFindCall) OnSave(user store.User) *userRepoSaveCall {
+	return _c.Parent.OnSave(user)
+}
+
+func (_c *userRepoFindCall) OnFindRaw(name interface{}) *userRepoFindCall {
+	return _c.Parent.OnFindRaw(name)
+}
+
+func (_c *userRepoFindCall) OnSaveRaw(user interface{}) *userRepoSaveCall {
+	return _c.Parent.OnSaveRaw(user)
+}
+
+func (_m *userRepoMock) Save(_ context.Context, user store.User) error {
+	_ret := _m.Called(user)
+
+	if _rf, ok := _ret.Get(0).(func(store.User) error); ok {
+		return _rf(user)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *userRepoMock) OnSave(user store.User) *userRepoSaveCall {
+	return &userRepoSaveCall{Call: _m.Mock.On("Save", user), Parent: _m}
+}
+
+func (_m *userRepoMock) OnSaveRaw(user interface{}) *userRepoSaveCall {
+	return &userRepoSaveCall{Call: _m.Mock.On("Save", user), Parent: _m}
+}
+
+type userRepoSaveCall struct {
+	*mock.Call
+	Parent *userRepoMock
+}
+
+func (_c *userRepoSaveCall) Panic(msg string) *userRepoSaveCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *userRepoSaveCall) Once() *userRepoSaveCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *userRepoSaveCall) Twice() *userRepoSaveCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *userRepoSaveCall) Times(i int) *userRepoSaveCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *userRepoSaveCall) WaitUntil(w <-chan time.Time) *userRepoSaveCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *userRepoSaveCall) After(d time.Duration) *userRepoSaveCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *userRepoSaveCall) Run(fn func(args mock.Arguments)) *userRepoSaveCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *userRepoSaveCall) Maybe() *userRepoSaveCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *userRepoSaveCall) TypedReturns(a error) *userRepoSaveCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *userRepoSaveCall) ReturnsFn(fn func(store.User) error) *userRepoSaveCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *userRepoSaveCall) TypedRun(fn func(store.User)) *userRepoSaveCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_user, _ := args.Get(0).(store.User)
+		fn(_user)
+	})
+	return _c
+}
+
+func (_c *userRepoSaveCall) OnFind(name string) *userRepoFindCall {
+	return _c.Parent.OnFind(name)
+}
+
+func (_c *userRepoSaveCall) OnSave(user store.User) *userRepoSaveCall {
+	return _c.Parent.OnSave(user)
+}
+
+func (_c *userRepoSaveCall) OnFindRaw(name interface{}) *userRepoFindCall {
+	return _c.Parent.OnFindRaw(name)
+}
+
+func (_c *userRepoSaveCall) OnSaveRaw(user interface{}) *userRepoSaveCall {
+	return _c.Parent.OnSaveRaw(user)
+}
+
+// clockMock mock of Clock.
+type clockMock struct{ mock.Mock }
+
+// newClockMock creates a new clockMock.
+func newClockMock(tb testing.TB) *clockMock {
+	tb.Helper()
+
+	m := &clockMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+// Func returns a fn2.Clock calling the mock.
+func (_m *clockMock) Func() fn2.Clock {
+	return _m.Execute
+}
+
+func (_m *clockMock) Execute() time.Time {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() time.Time); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(time.Time)
+
+	return _ra0
+}
+
+func (_m *clockMock) OnExecute() *clockExecuteCall {
+	return &clockExecuteCall{Call: _m.Mock.On("Execute"), Parent: _m}
+}
+
+func (_m *clockMock) OnExecuteRaw() *clockExecuteCall {
+	return &clockExecuteCall{Call: _m.Mock.On("Execute"), Parent: _m}
+}
+
+type clockExecuteCall struct {
+	*mock.Call
+	Parent *clockMock
+}
+
+func (_c *clockExecuteCall) Panic(msg string) *clockExecuteCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *clockExecuteCall) Once() *clockExecuteCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *clockExecuteCall) Twice() *clockExecuteCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *clockExecuteCall) Times(i int) *clockExecuteCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *clockExecuteCall) WaitUntil(w <-chan time.Time) *clockExecuteCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *clockExecuteCall) After(d time.Duration) *clockExecuteCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *clockExecuteCall) Run(fn func(args mock.Arguments)) *clockExecuteCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *clockExecuteCall) Maybe() *clockExecuteCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *clockExecuteCall) TypedReturns(a time.Time) *clockExecuteCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *clockExecuteCall) ReturnsFn(fn func() time.Time) *clockExecuteCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *clockExecuteCall) TypedRun(fn func()) *clockExecuteCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *clockExecuteCall) OnExecute() *clockExecuteCall {
+	return _c.Parent.OnExecute()
+}
+
+func (_c *clockExecuteCall) OnExecuteRaw() *clockExecuteCall {
+	return _c.Parent.OnExecuteRaw()
+}
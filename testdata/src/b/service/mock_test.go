@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"b/fn"
+	"b/store"
+)
+
+// mocktail:store./.*Repo$/
+// mocktail-:store.OrderRepo
+// mocktail:fn.Clock
+
+func TestService(t *testing.T) {
+	var u store.UserRepo = newUserRepoMock(t).
+		OnSave(store.User{Name: "a"}).TypedReturns(nil).Once().
+		Parent
+
+	_ = u.Save(context.Background(), store.User{Name: "a"})
+
+	now := time.Now()
+
+	var clock fn.Clock = newClockMock(t).
+		OnExecute().TypedReturns(now).Once().
+		Parent.Func()
+
+	clock()
+}
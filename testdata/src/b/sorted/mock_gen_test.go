@@ -0,0 +1,340 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package sorted
+
+import (
+	"b/store"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// indexMock mock of Index.
+type indexMock[K Key, V fmt.Stringer] struct{ mock.Mock }
+
+// newIndexMock creates a new indexMock.
+func newIndexMock[K Key, V fmt.Stringer](tb testing.TB) *indexMock[K, V] {
+	tb.Helper()
+
+	m := &indexMock[K, V]{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *indexMock[K, V]) Get(key K) (V, bool) {
+	_ret := _m.Called(key)
+
+	if _rf, ok := _ret.Get(0).(func(K) (V, bool)); ok {
+		return _rf(key)
+	}
+
+	_ra0, _ := _ret.Get(0).(V)
+	_rb1 := _ret.Bool(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *indexMock[K, V]) OnGet(key K) *indexGetCall[K, V] {
+	return &indexGetCall[K, V]{Call: _m.Mock.On("Get", key), Parent: _m}
+}
+
+func (_m *indexMock[K, V]) OnGetRaw(key interface{}) *indexGetCall[K, V] {
+	return &indexGetCall[K, V]{Call: _m.Mock.On("Get", key), Parent: _m}
+}
+
+type indexGetCall[K Key, V fmt.Stringer] struct {
+	*mock.Call
+	Parent *indexMock[K, V]
+}
+
+func (_c *indexGetCall[K, V]) Panic(msg string) *indexGetCall[K, V] {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *indexGetCall[K, V]) Once() *indexGetCall[K, V] {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *indexGetCall[K, V]) Twice() *indexGetCall[K, V] {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *indexGetCall[K, V]) Times(i int) *indexGetCall[K, V] {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *indexGetCall[K, V]) WaitUntil(w <-chan time.Time) *indexGetCall[K, V] {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *indexGetCall[K, V]) After(d time.Duration) *indexGetCall[K, V] {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *indexGetCall[K, V]) Run(fn func(args mock.Arguments)) *indexGetCall[K, V] {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *indexGetCall[K, V]) Maybe() *indexGetCall[K, V] {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *indexGetCall[K, V]) TypedReturns(a V, b bool) *indexGetCall[K, V] {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *indexGetCall[K, V]) ReturnsFn(fn func(K) (V, bool)) *indexGetCall[K, V] {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *indexGetCall[K, V]) TypedRun(fn func(K)) *indexGetCall[K, V] {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_key, _ := args.Get(0).(K)
+		fn(_key)
+	})
+	return _c
+}
+
+func (_c *indexGetCall[K, V]) OnGet(key K) *indexGetCall[K, V] {
+	return _c.Parent.OnGet(key)
+}
+
+func (_c *indexGetCall[K, V]) OnGetRaw(key interface{}) *indexGetCall[K, V] {
+	return _c.Parent.OnGetRaw(key)
+}
+
+// rankerMock mock of Ranker.
+type rankerMock[T store.Number, S ~[]T] struct{ mock.Mock }
+
+// newRankerMock creates a new rankerMock.
+func newRankerMock[T store.Number, S ~[]T](tb testing.TB) *rankerMock[T, S] {
+	tb.Helper()
+
+	m := &rankerMock[T, S]{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *rankerMock[T, S]) Rank(values S) T {
+	_ret := _m.Called(values)
+
+	if _rf, ok := _ret.Get(0).(func(S) T); ok {
+		return _rf(values)
+	}
+
+	_ra0, _ := _ret.Get(0).(T)
+
+	return _ra0
+}
+
+func (_m *rankerMock[T, S]) OnRank(values S) *rankerRankCall[T, S] {
+	return &rankerRankCall[T, S]{Call: _m.Mock.On("Rank", values), Parent: _m}
+}
+
+func (_m *rankerMock[T, S]) OnRankRaw(values interface{}) *rankerRankCall[T, S] {
+	return &rankerRankCall[T, S]{Call: _m.Mock.On("Rank", values), Parent: _m}
+}
+
+type rankerRankCall[T store.Number, S ~[]T] struct {
+	*mock.Call
+	Parent *rankerMock[T, S]
+}
+
+func (_c *rankerRankCall[T, S]) Panic(msg string) *rankerRankCall[T, S] {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *rankerRankCall[T, S]) Once() *rankerRankCall[T, S] {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *rankerRankCall[T, S]) Twice() *rankerRankCall[T, S] {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *rankerRankCall[T, S]) Times(i int) *rankerRankCall[T, S] {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *rankerRankCall[T, S]) WaitUntil(w <-chan time.Time) *rankerRankCall[T, S] {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *rankerRankCall[T, S]) After(d time.Duration) *rankerRankCall[T, S] {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *rankerRankCall[T, S]) Run(fn func(args mock.Arguments)) *rankerRankCall[T, S] {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *rankerRankCall[T, S]) Maybe() *rankerRankCall[T, S] {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *rankerRankCall[T, S]) TypedReturns(a T) *rankerRankCall[T, S] {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *rankerRankCall[T, S]) ReturnsFn(fn func(S) T) *rankerRankCall[T, S] {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *rankerRankCall[T, S]) TypedRun(fn func(S)) *rankerRankCall[T, S] {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_values, _ := args.Get(0).(S)
+		fn(_values)
+	})
+	return _c
+}
+
+func (_c *rankerRankCall[T, S]) OnRank(values S) *rankerRankCall[T, S] {
+	return _c.Parent.OnRank(values)
+}
+
+func (_c *rankerRankCall[T, S]) OnRankRaw(values interface{}) *rankerRankCall[T, S] {
+	return _c.Parent.OnRankRaw(values)
+}
+
+// labelerMock mock of Labeler.
+type labelerMock[T interface {
+	~int | ~int64
+	fmt.Stringer
+}, L ~string | store.User] struct{ mock.Mock }
+
+// newLabelerMock creates a new labelerMock.
+func newLabelerMock[T interface {
+	~int | ~int64
+	fmt.Stringer
+}, L ~string | store.User](tb testing.TB) *labelerMock[T, L] {
+	tb.Helper()
+
+	m := &labelerMock[T, L]{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *labelerMock[T, L]) Label(value T) L {
+	_ret := _m.Called(value)
+
+	if _rf, ok := _ret.Get(0).(func(T) L); ok {
+		return _rf(value)
+	}
+
+	_ra0, _ := _ret.Get(0).(L)
+
+	return _ra0
+}
+
+func (_m *labelerMock[T, L]) OnLabel(value T) *labelerLabelCall[T, L] {
+	return &labelerLabelCall[T, L]{Call: _m.Mock.On("Label", value), Parent: _m}
+}
+
+func (_m *labelerMock[T, L]) OnLabelRaw(value interface{}) *labelerLabelCall[T, L] {
+	return &labelerLabelCall[T, L]{Call: _m.Mock.On("Label", value), Parent: _m}
+}
+
+type labelerLabelCall[T interface {
+	~int | ~int64
+	fmt.Stringer
+}, L ~string | store.User] struct {
+	*mock.Call
+	Parent *labelerMock[T, L]
+}
+
+func (_c *labelerLabelCall[T, L]) Panic(msg string) *labelerLabelCall[T, L] {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *labelerLabelCall[T, L]) Once() *labelerLabelCall[T, L] {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *labelerLabelCall[T, L]) Twice() *labelerLabelCall[T, L] {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *labelerLabelCall[T, L]) Times(i int) *labelerLabelCall[T, L] {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *labelerLabelCall[T, L]) WaitUntil(w <-chan time.Time) *labelerLabelCall[T, L] {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *labelerLabelCall[T, L]) After(d time.Duration) *labelerLabelCall[T, L] {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *labelerLabelCall[T, L]) Run(fn func(args mock.Arguments)) *labelerLabelCall[T, L] {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *labelerLabelCall[T, L]) Maybe() *labelerLabelCall[T, L] {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *labelerLabelCall[T, L]) TypedReturns(a L) *labelerLabelCall[T, L] {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *labelerLabelCall[T, L]) ReturnsFn(fn func(T) L) *labelerLabelCall[T, L] {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *labelerLabelCall[T, L]) TypedRun(fn func(T)) *labelerLabelCall[T, L] {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_value, _ := args.Get(0).(T)
+		fn(_value)
+	})
+	return _c
+}
+
+func (_c *labelerLabelCall[T, L]) OnLabel(value T) *labelerLabelCall[T, L] {
+	return _c.Parent.OnLabel(value)
+}
+
+func (_c *labelerLabelCall[T, L]) OnLabelRaw(value interface{}) *labelerLabelCall[T, L] {
+	return _c.Parent.OnLabelRaw(value)
+}
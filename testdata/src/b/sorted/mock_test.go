@@ -0,0 +1,41 @@
+package sorted
+
+import (
+	"testing"
+)
+
+// mocktail:Index
+// mocktail:Ranker
+// mocktail:Labeler
+
+type name string
+
+func (n name) String() string { return string(n) }
+
+type level int
+
+func (l level) String() string { return "level" }
+
+func TestIndex(t *testing.T) {
+	var index Index[string, name] = newIndexMock[string, name](t).
+		OnGet("a").TypedReturns("b", true).Once().
+		Parent
+
+	_, _ = index.Get("a")
+}
+
+func TestRanker(t *testing.T) {
+	var ranker Ranker[int, []int] = newRankerMock[int, []int](t).
+		OnRank([]int{1, 2}).TypedReturns(2).Once().
+		Parent
+
+	_ = ranker.Rank([]int{1, 2})
+}
+
+func TestLabeler(t *testing.T) {
+	var labeler Labeler[level, string] = newLabelerMock[level, string](t).
+		OnLabel(1).TypedReturns("one").Once().
+		Parent
+
+	_ = labeler.Label(1)
+}
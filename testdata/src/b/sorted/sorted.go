@@ -0,0 +1,26 @@
+package sorted
+
+import (
+	"fmt"
+
+	"b/store"
+)
+
+type Key interface {
+	~int | ~string
+}
+
+type Index[K Key, V fmt.Stringer] interface {
+	Get(key K) (V, bool)
+}
+
+type Ranker[T store.Number, S ~[]T] interface {
+	Rank(values S) T
+}
+
+type Labeler[T interface {
+	~int | ~int64
+	fmt.Stringer
+}, L ~string | store.User] interface {
+	Label(value T) L
+}
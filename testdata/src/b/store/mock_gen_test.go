@@ -0,0 +1,331 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// orderRepoMock mock of OrderRepo.
+type orderRepoMock struct{ mock.Mock }
+
+// newOrderRepoMock creates a new orderRepoMock.
+func newOrderRepoMock(tb testing.TB) *orderRepoMock {
+	tb.Helper()
+
+	m := &orderRepoMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *orderRepoMock) Count(_ context.Context) (int, error) {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() (int, error)); ok {
+		return _rf()
+	}
+
+	_ra0 := _ret.Int(0)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *orderRepoMock) OnCount() *orderRepoCountCall {
+	return &orderRepoCountCall{Call: _m.Mock.On("Count"), Parent: _m}
+}
+
+func (_m *orderRepoMock) OnCountRaw() *orderRepoCountCall {
+	return &orderRepoCountCall{Call: _m.Mock.On("Count"), Parent: _m}
+}
+
+type orderRepoCountCall struct {
+	*mock.Call
+	Parent *orderRepoMock
+}
+
+func (_c *orderRepoCountCall) Panic(msg string) *orderRepoCountCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *orderRepoCountCall) Once() *orderRepoCountCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *orderRepoCountCall) Twice() *orderRepoCountCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *orderRepoCountCall) Times(i int) *orderRepoCountCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *orderRepoCountCall) WaitUntil(w <-chan time.Time) *orderRepoCountCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *orderRepoCountCall) After(d time.Duration) *orderRepoCountCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *orderRepoCountCall) Run(fn func(args mock.Arguments)) *orderRepoCountCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *orderRepoCountCall) Maybe() *orderRepoCountCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *orderRepoCountCall) TypedReturns(a int, b error) *orderRepoCountCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *orderRepoCountCall) ReturnsFn(fn func() (int, error)) *orderRepoCountCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *orderRepoCountCall) TypedRun(fn func()) *orderRepoCountCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *orderRepoCountCall) OnCount() *orderRepoCountCall {
+	return _c.Parent.OnCount()
+}
+
+func (_c *orderRepoCountCall) OnCountRaw() *orderRepoCountCall {
+	return _c.Parent.OnCountRaw()
+}
+
+// userRepoMock mock of UserRepo.
+type userRepoMock struct{ mock.Mock }
+
+// newUserRepoMock creates a new userRepoMock.
+func newUserRepoMock(tb testing.TB) *userRepoMock {
+	tb.Helper()
+
+	m := &userRepoMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *userRepoMock) Find(_ context.Context, name string) (User, error) {
+	_ret := _m.Called(name)
+
+	if _rf, ok := _ret.Get(0).(func(string) (User, error)); ok {
+		return _rf(name)
+	}
+
+	_ra0, _ := _ret.Get(0).(User)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *userRepoMock) OnFind(name string) *userRepoFindCall {
+	return &userRepoFindCall{Call: _m.Mock.On("Find", name), Parent: _m}
+}
+
+func (_m *userRepoMock) OnFindRaw(name interface{}) *userRepoFindCall {
+	return &userRepoFindCall{Call: _m.Mock.On("Find", name), Parent: _m}
+}
+
+type userRepoFindCall struct {
+	*mock.Call
+	Parent *userRepoMock
+}
+
+func (_c *userRepoFindCall) Panic(msg string) *userRepoFindCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *userRepoFindCall) Once() *userRepoFindCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *userRepoFindCall) Twice() *userRepoFindCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *userRepoFindCall) Times(i int) *userRepoFindCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *userRepoFindCall) WaitUntil(w <-chan time.Time) *userRepoFindCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *userRepoFindCall) After(d time.Duration) *userRepoFindCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *userRepoFindCall) Run(fn func(args mock.Arguments)) *userRepoFindCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *userRepoFindCall) Maybe() *userRepoFindCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *userRepoFindCall) TypedReturns(a User, b error) *userRepoFindCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *userRepoFindCall) ReturnsFn(fn func(string) (User, error)) *userRepoFindCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *userRepoFindCall) TypedRun(fn func(string)) *userRepoFindCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_name := args.String(0)
+		fn(_name)
+	})
+	return _c
+}
+
+func (_c *userRepoFindCall) OnFind(name string) *userRepoFindCall {
+	return _c.Parent.OnFind(name)
+}
+
+func (_c *userRepoFindCall) OnSave(user User) *userRepoSaveCall {
+	return _c.Parent.OnSave(user)
+}
+
+func (_c *userRepoFindCall) OnFindRaw(name interface{}) *userRepoFindCall {
+	return _c.Parent.OnFindRaw(name)
+}
+
+func (_c *userRepoFindCall) OnSaveRaw(user interface{}) *userRepoSaveCall {
+	return _c.Parent.OnSaveRaw(user)
+}
+
+func (_m *userRepoMock) Save(_ context.Context, user User) error {
+	_ret := _m.Called(user)
+
+	if _rf, ok := _ret.Get(0).(func(User) error); ok {
+		return _rf(user)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *userRepoMock) OnSave(user User) *userRepoSaveCall {
+	return &userRepoSaveCall{Call: _m.Mock.On("Save", user), Parent: _m}
+}
+
+func (_m *userRepoMock) OnSaveRaw(user interface{}) *userRepoSaveCall {
+	return &userRepoSaveCall{Call: _m.Mock.On("Save", user), Parent: _m}
+}
+
+type userRepoSaveCall struct {
+	*mock.Call
+	Parent *userRepoMock
+}
+
+func (_c *userRepoSaveCall) Panic(msg string) *userRepoSaveCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *userRepoSaveCall) Once() *userRepoSaveCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *userRepoSaveCall) Twice() *userRepoSaveCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *userRepoSaveCall) Times(i int) *userRepoSaveCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *userRepoSaveCall) WaitUntil(w <-chan time.Time) *userRepoSaveCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *userRepoSaveCall) After(d time.Duration) *userRepoSaveCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *userRepoSaveCall) Run(fn func(args mock.Arguments)) *userRepoSaveCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *userRepoSaveCall) Maybe() *userRepoSaveCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *userRepoSaveCall) TypedReturns(a error) *userRepoSaveCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *userRepoSaveCall) ReturnsFn(fn func(User) error) *userRepoSaveCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *userRepoSaveCall) TypedRun(fn func(User)) *userRepoSaveCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_user, _ := args.Get(0).(User)
+		fn(_user)
+	})
+	return _c
+}
+
+func (_c *userRepoSaveCall) OnFind(name string) *userRepoFindCall {
+	return _c.Parent.OnFind(name)
+}
+
+func (_c *userRepoSaveCall) OnSave(user User) *userRepoSaveCall {
+	return _c.Parent.OnSave(user)
+}
+
+func (_c *userRepoSaveCall) OnFindRaw(name interface{}) *userRepoFindCall {
+	return _c.Parent.OnFindRaw(name)
+}
+
+func (_c *userRepoSaveCall) OnSaveRaw(user interface{}) *userRepoSaveCall {
+	return _c.Parent.OnSaveRaw(user)
+}
@@ -0,0 +1,23 @@
+package store
+
+import (
+	"context"
+	"testing"
+)
+
+// mocktail:*
+// mocktail-:Cache
+
+func TestStore(t *testing.T) {
+	var u UserRepo = newUserRepoMock(t).
+		OnFind("a").TypedReturns(User{Name: "a"}, nil).Once().
+		Parent
+
+	_, _ = u.Find(context.Background(), "a")
+
+	var o OrderRepo = newOrderRepoMock(t).
+		OnCount().TypedReturns(1, nil).Once().
+		Parent
+
+	_, _ = o.Count(context.Background())
+}
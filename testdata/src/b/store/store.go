@@ -0,0 +1,30 @@
+package store
+
+import "context"
+
+type User struct {
+	Name string
+}
+
+type UserRepo interface {
+	Find(ctx context.Context, name string) (User, error)
+	Save(ctx context.Context, user User) error
+}
+
+type OrderRepo interface {
+	Count(ctx context.Context) (int, error)
+}
+
+type Cache interface {
+	Get(key string) (string, bool)
+}
+
+type logger interface {
+	Log(msg string)
+}
+
+type Number interface {
+	~int | ~float64
+}
+
+type Empty interface{}
@@ -0,0 +1,7 @@
+package app
+
+import "example.com/lib"
+
+type Service interface {
+	Store() lib.Store
+}
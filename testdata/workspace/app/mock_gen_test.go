@@ -0,0 +1,223 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"example.com/lib"
+	"github.com/stretchr/testify/mock"
+)
+
+// serviceMock mock of Service.
+type serviceMock struct{ mock.Mock }
+
+// newServiceMock creates a new serviceMock.
+func newServiceMock(tb testing.TB) *serviceMock {
+	tb.Helper()
+
+	m := &serviceMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *serviceMock) Store() lib.Store {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() lib.Store); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(lib.Store)
+
+	return _ra0
+}
+
+func (_m *serviceMock) OnStore() *serviceStoreCall {
+	return &serviceStoreCall{Call: _m.Mock.On("Store"), Parent: _m}
+}
+
+func (_m *serviceMock) OnStoreRaw() *serviceStoreCall {
+	return &serviceStoreCall{Call: _m.Mock.On("Store"), Parent: _m}
+}
+
+type serviceStoreCall struct {
+	*mock.Call
+	Parent *serviceMock
+}
+
+func (_c *serviceStoreCall) Panic(msg string) *serviceStoreCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *serviceStoreCall) Once() *serviceStoreCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *serviceStoreCall) Twice() *serviceStoreCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *serviceStoreCall) Times(i int) *serviceStoreCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *serviceStoreCall) WaitUntil(w <-chan time.Time) *serviceStoreCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *serviceStoreCall) After(d time.Duration) *serviceStoreCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *serviceStoreCall) Run(fn func(args mock.Arguments)) *serviceStoreCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *serviceStoreCall) Maybe() *serviceStoreCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *serviceStoreCall) TypedReturns(a lib.Store) *serviceStoreCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *serviceStoreCall) ReturnsFn(fn func() lib.Store) *serviceStoreCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *serviceStoreCall) TypedRun(fn func()) *serviceStoreCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *serviceStoreCall) OnStore() *serviceStoreCall {
+	return _c.Parent.OnStore()
+}
+
+func (_c *serviceStoreCall) OnStoreRaw() *serviceStoreCall {
+	return _c.Parent.OnStoreRaw()
+}
+
+// storeMock mock of Store.
+type storeMock struct{ mock.Mock }
+
+// newStoreMock creates a new storeMock.
+func newStoreMock(tb testing.TB) *storeMock {
+	tb.Helper()
+
+	m := &storeMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *storeMock) Get(key string) (string, error) {
+	_ret := _m.Called(key)
+
+	if _rf, ok := _ret.Get(0).(func(string) (string, error)); ok {
+		return _rf(key)
+	}
+
+	_ra0 := _ret.String(0)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *storeMock) OnGet(key string) *storeGetCall {
+	return &storeGetCall{Call: _m.Mock.On("Get", key), Parent: _m}
+}
+
+func (_m *storeMock) OnGetRaw(key interface{}) *storeGetCall {
+	return &storeGetCall{Call: _m.Mock.On("Get", key), Parent: _m}
+}
+
+type storeGetCall struct {
+	*mock.Call
+	Parent *storeMock
+}
+
+func (_c *storeGetCall) Panic(msg string) *storeGetCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *storeGetCall) Once() *storeGetCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *storeGetCall) Twice() *storeGetCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *storeGetCall) Times(i int) *storeGetCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *storeGetCall) WaitUntil(w <-chan time.Time) *storeGetCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *storeGetCall) After(d time.Duration) *storeGetCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *storeGetCall) Run(fn func(args mock.Arguments)) *storeGetCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *storeGetCall) Maybe() *storeGetCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *storeGetCall) TypedReturns(a string, b error) *storeGetCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *storeGetCall) ReturnsFn(fn func(string) (string, error)) *storeGetCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *storeGetCall) TypedRun(fn func(string)) *storeGetCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_key := args.String(0)
+		fn(_key)
+	})
+	return _c
+}
+
+func (_c *storeGetCall) OnGet(key string) *storeGetCall {
+	return _c.Parent.OnGet(key)
+}
+
+func (_c *storeGetCall) OnGetRaw(key interface{}) *storeGetCall {
+	return _c.Parent.OnGetRaw(key)
+}
@@ -0,0 +1,22 @@
+package app
+
+import (
+	"testing"
+
+	"example.com/lib"
+)
+
+// mocktail:Service
+// mocktail:example.com/lib.Store
+
+func TestService(t *testing.T) {
+	var store lib.Store = newStoreMock(t).
+		OnGet("a").TypedReturns("b", nil).Once().
+		Parent
+
+	var service Service = newServiceMock(t).
+		OnStore().TypedReturns(store).Once().
+		Parent
+
+	_, _ = service.Store().Get("a")
+}
@@ -0,0 +1,115 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package nested
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// clockMock mock of Clock.
+type clockMock struct{ mock.Mock }
+
+// newClockMock creates a new clockMock.
+func newClockMock(tb testing.TB) *clockMock {
+	tb.Helper()
+
+	m := &clockMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *clockMock) Now() int64 {
+	_ret := _m.Called()
+
+	if _rf, ok := _ret.Get(0).(func() int64); ok {
+		return _rf()
+	}
+
+	_ra0, _ := _ret.Get(0).(int64)
+
+	return _ra0
+}
+
+func (_m *clockMock) OnNow() *clockNowCall {
+	return &clockNowCall{Call: _m.Mock.On("Now"), Parent: _m}
+}
+
+func (_m *clockMock) OnNowRaw() *clockNowCall {
+	return &clockNowCall{Call: _m.Mock.On("Now"), Parent: _m}
+}
+
+type clockNowCall struct {
+	*mock.Call
+	Parent *clockMock
+}
+
+func (_c *clockNowCall) Panic(msg string) *clockNowCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *clockNowCall) Once() *clockNowCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *clockNowCall) Twice() *clockNowCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *clockNowCall) Times(i int) *clockNowCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *clockNowCall) WaitUntil(w <-chan time.Time) *clockNowCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *clockNowCall) After(d time.Duration) *clockNowCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *clockNowCall) Run(fn func(args mock.Arguments)) *clockNowCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *clockNowCall) Maybe() *clockNowCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *clockNowCall) TypedReturns(a int64) *clockNowCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *clockNowCall) ReturnsFn(fn func() int64) *clockNowCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *clockNowCall) TypedRun(fn func()) *clockNowCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return _c
+}
+
+func (_c *clockNowCall) OnNow() *clockNowCall {
+	return _c.Parent.OnNow()
+}
+
+func (_c *clockNowCall) OnNowRaw() *clockNowCall {
+	return _c.Parent.OnNowRaw()
+}
@@ -0,0 +1,5 @@
+package nested
+
+type Clock interface {
+	Now() int64
+}
@@ -0,0 +1,5 @@
+package lib
+
+type Store interface {
+	Get(key string) (string, error)
+}
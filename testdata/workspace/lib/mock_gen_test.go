@@ -0,0 +1,117 @@
+// Code generated by mocktail; DO NOT EDIT.
+
+package lib
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// storeMock mock of Store.
+type storeMock struct{ mock.Mock }
+
+// newStoreMock creates a new storeMock.
+func newStoreMock(tb testing.TB) *storeMock {
+	tb.Helper()
+
+	m := &storeMock{}
+	m.Mock.Test(tb)
+
+	tb.Cleanup(func() { m.AssertExpectations(tb) })
+
+	return m
+}
+
+func (_m *storeMock) Get(key string) (string, error) {
+	_ret := _m.Called(key)
+
+	if _rf, ok := _ret.Get(0).(func(string) (string, error)); ok {
+		return _rf(key)
+	}
+
+	_ra0 := _ret.String(0)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *storeMock) OnGet(key string) *storeGetCall {
+	return &storeGetCall{Call: _m.Mock.On("Get", key), Parent: _m}
+}
+
+func (_m *storeMock) OnGetRaw(key interface{}) *storeGetCall {
+	return &storeGetCall{Call: _m.Mock.On("Get", key), Parent: _m}
+}
+
+type storeGetCall struct {
+	*mock.Call
+	Parent *storeMock
+}
+
+func (_c *storeGetCall) Panic(msg string) *storeGetCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *storeGetCall) Once() *storeGetCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *storeGetCall) Twice() *storeGetCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *storeGetCall) Times(i int) *storeGetCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *storeGetCall) WaitUntil(w <-chan time.Time) *storeGetCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *storeGetCall) After(d time.Duration) *storeGetCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *storeGetCall) Run(fn func(args mock.Arguments)) *storeGetCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *storeGetCall) Maybe() *storeGetCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *storeGetCall) TypedReturns(a string, b error) *storeGetCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *storeGetCall) ReturnsFn(fn func(string) (string, error)) *storeGetCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *storeGetCall) TypedRun(fn func(string)) *storeGetCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_key := args.String(0)
+		fn(_key)
+	})
+	return _c
+}
+
+func (_c *storeGetCall) OnGet(key string) *storeGetCall {
+	return _c.Parent.OnGet(key)
+}
+
+func (_c *storeGetCall) OnGetRaw(key interface{}) *storeGetCall {
+	return _c.Parent.OnGetRaw(key)
+}